@@ -41,10 +41,13 @@ const defaultConfigFile = `# # lc (list cloud) 的云服务商配置文件
 
 # # 天翼云
 # # 访问凭证获取地址：https://oos-cn.ctyun.cn/oos/ctyun/iam/dist/index.html#/certificate
+# # ECS 和 EIP 使用 OpenAPI 访问凭证，如果与 OOS 凭证不同，可以再添加一个 tianyi 配置
 # - provider: tianyi
 #  id: tianyi_cloud_default
 #  access_key: 
 #  secret_key:
+#  # 可选，OOS 资源池节点，默认为 oos-cn.ctyunapi.cn
+#  endpoint: 
 
 # # 百度云
 # # 访问凭证获取地址：https://console.bce.baidu.com/iam/
@@ -61,6 +64,8 @@ const defaultConfigFile = `# # lc (list cloud) 的云服务商配置文件
 #   access_key: 
 #   secret_key: 
 #   session_token: 
+#   # 可选，OpenAPI 域名后缀，默认为 cucloud.cn
+#   endpoint: 
 
 # # 七牛云
 # # 访问凭证获取地址：https://portal.qiniu.com/developer/user/key
@@ -76,4 +81,121 @@ const defaultConfigFile = `# # lc (list cloud) 的云服务商配置文件
 #   access_key: 
 #   secret_key: 
 #   session_token: 
+#   # 可选，OpenAPI 地址，多个资源池之间使用逗号分隔，默认为 ecloud.10086.cn
+#   endpoint: 
+
+# # AWS
+# # 访问凭证获取地址：https://console.aws.amazon.com/iam
+# - provider: aws
+#   id: aws_default
+#   access_key: 
+#   secret_key: 
+#   session_token: 
+#   # 可选，默认区域，默认为 us-east-1
+#   region: 
+#   # 可选，自定义 endpoint，例如 http://127.0.0.1:5000，配置后只获取 region 区域下的资源
+#   endpoint: 
+
+# # 火山引擎
+# # 访问凭证获取地址：https://console.volcengine.com/iam/keymanage
+# - provider: volcengine
+#   id: volcengine_default
+#   access_key: 
+#   secret_key: 
+#   session_token: 
+
+# # 京东云
+# # 访问凭证获取地址：https://uc.jdcloud.com/account/accesskey
+# - provider: jdcloud
+#   id: jdcloud_default
+#   access_key: 
+#   secret_key: 
+#   session_token: 
+
+# # 金山云
+# # 访问凭证获取地址：https://uc.console.ksyun.com/pro/iam/#/user/accesskey
+# - provider: kingsoft
+#   id: kingsoft_default
+#   access_key: 
+#   secret_key: 
+#   session_token: 
+
+# # UCloud
+# # 访问凭证获取地址：https://console.ucloud.cn/uaccount/api_manage
+# - provider: ucloud
+#   id: ucloud_default
+#   access_key: 
+#   secret_key: 
+
+# # Azure
+# # 访问凭证获取地址：https://portal.azure.com/#view/Microsoft_AAD_IAM/ActiveDirectoryMenuBlade/~/RegisteredApps
+# - provider: azure
+#   id: azure_default
+#   # access_key 为应用程序（客户端）ID，secret_key 为客户端密码
+#   access_key: 
+#   secret_key: 
+#   tenant_id: 
+#   # 可选，默认获取所有已启用的订阅
+#   subscription_id: 
+#   # 可选，Resource Manager 地址，默认为 https://management.azure.com
+#   endpoint: 
+#   # 可选，登录地址，默认为 https://login.microsoftonline.com
+#   auth_endpoint: 
+
+# # GCP
+# # 访问凭证获取地址：https://console.cloud.google.com/iam-admin/serviceaccounts
+# - provider: gcp
+#   id: gcp_default
+#   # 服务账号 JSON 密钥文件的路径
+#   credentials_file: 
+#   # 可选，未配置 credentials_file 时可以使用 gcloud auth print-access-token 获取的访问令牌
+#   session_token: 
+#   # 可选，默认使用密钥文件中的 project_id
+#   project_id: 
+#   # 可选，API 地址，默认为 https://www.googleapis.com
+#   endpoint: 
+#   # 可选，获取访问令牌的地址，默认使用密钥文件中的 token_uri
+#   auth_endpoint: 
+
+# # Cloudflare
+# # 访问凭证获取地址：https://dash.cloudflare.com/profile/api-tokens
+# - provider: cloudflare
+#   id: cloudflare_default
+#   # API 令牌需要 Zone:Read 和 DNS:Read 权限
+#   api_token: 
+
+# # Kubernetes
+# - provider: kubernetes
+#   id: kubernetes_default
+#   # 可选，kubeconfig 文件路径，默认使用 KUBECONFIG 环境变量或者 ~/.kube/config
+#   kubeconfig: 
+#   # 可选，kubeconfig 中的上下文，默认为 current-context
+#   context: 
+
+# # OpenStack
+# - provider: openstack
+#   id: openstack_default
+#   # access_key 为用户名，secret_key 为密码
+#   access_key: 
+#   secret_key: 
+#   # Keystone 认证地址，例如 https://openstack.example.com:5000/v3
+#   auth_url: 
+#   project: 
+#   # 可选，用户和项目所在的域，默认为 Default
+#   domain: 
+#   # 可选，默认获取服务目录中所有区域的资源
+#   region: 
+
+# # S3 兼容存储，例如 MinIO、Ceph RGW
+# - provider: s3
+#   id: s3_default
+#   access_key: 
+#   secret_key: 
+#   session_token: 
+#   # S3 地址，多个地址之间使用逗号分隔，例如 http://10.0.0.5:9000,s3.example.com
+#   endpoint: 
+#   # 可选，默认为 us-east-1
+#   region: 
+#   # 可选，是否使用路径方式访问存储桶，MinIO 等不支持子域名访问的服务需要设置为 true
+#   path_style: 
 `
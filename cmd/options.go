@@ -14,11 +14,13 @@ import (
 type Options struct {
 	Threads        int                 // Threads 设置线程数量
 	Silent         bool                // Silent 只展示结果
+	JSON           bool                // JSON 以 JSONL 格式输出结果
 	Debug          bool                // Debug 显示详细的输出信息
 	Version        bool                // Version 返回工具版本
 	ExcludePrivate bool                // ExcludePrivate 从结果中排除私有 IP
 	Config         string              // Config 指定配置文件路径
 	Output         string              // Output 将结果写入到文件中
+	Format         string              // Format 指定输出格式
 	Provider       goflags.StringSlice // Provider 指定要列出的云服务商
 	Id             goflags.StringSlice // Id 指定要列出的对象
 }
@@ -43,7 +45,9 @@ func ParseOptions() *Options {
 	)
 	flagSet.CreateGroup("output", "输出",
 		flagSet.StringVarP(&options.Output, "output", "o", "", "将结果输出到指定的文件中"),
+		flagSet.StringVarP(&options.Format, "format", "f", formatText, "指定输出格式 (text, json, csv, markdown, html)"),
 		flagSet.BoolVarP(&options.Silent, "silent", "s", false, "只输出结果"),
+		flagSet.BoolVarP(&options.JSON, "json", "jsonl", false, "以 JSONL 格式输出结果（每行一个资产对象）"),
 		flagSet.BoolVarP(&options.Version, "version", "v", false, "输出工具的版本"),
 		flagSet.BoolVar(&options.Debug, "debug", false, "输出调试日志信息"),
 	)
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	formatText     = "text"
+	formatJSON     = "json"
+	formatCSV      = "csv"
+	formatMarkdown = "markdown"
+	formatHTML     = "html"
+)
+
+// outputWriter 负责将列出的资产写入到终端和输出文件中
+type outputWriter interface {
+	Write(resource *schema.Resource) error
+	WriteSummary(name, id string, summary []schema.Summary) error
+	Close() error
+}
+
+func newOutputWriter(format string, output *os.File) (outputWriter, error) {
+	switch strings.ToLower(format) {
+	case "", formatText:
+		return &textWriter{output: output}, nil
+	case formatJSON, "jsonl":
+		return &jsonWriter{output: output}, nil
+	case formatCSV:
+		return &csvWriter{output: output}, nil
+	case formatMarkdown, "md":
+		return &reportWriter{output: output, render: renderMarkdown}, nil
+	case formatHTML:
+		return &reportWriter{output: output, render: renderHTML}, nil
+	default:
+		return nil, fmt.Errorf("不支持的输出格式: %s", format)
+	}
+}
+
+type asset struct {
+	Kind  string
+	Value string
+}
+
+// resourceAssets 返回资产中所有不为空的地址
+func resourceAssets(resource *schema.Resource) []asset {
+	var assets []asset
+	if resource.DNSName != "" {
+		assets = append(assets, asset{Kind: "dns_name", Value: resource.DNSName})
+	}
+	if resource.PublicIPv4 != "" {
+		assets = append(assets, asset{Kind: "public_ipv4", Value: resource.PublicIPv4})
+	}
+	if resource.PrivateIpv4 != "" {
+		assets = append(assets, asset{Kind: "private_ipv4", Value: resource.PrivateIpv4})
+	}
+	if resource.PublicIPv6 != "" {
+		assets = append(assets, asset{Kind: "public_ipv6", Value: resource.PublicIPv6})
+	}
+	if resource.PrivateIpv6 != "" {
+		assets = append(assets, asset{Kind: "private_ipv6", Value: resource.PrivateIpv6})
+	}
+	return assets
+}
+
+// formatMetadata 将资产的附加信息按 key 排序后格式化为 key=value; key=value
+func formatMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, key+"="+metadata[key])
+	}
+	return strings.Join(items, "; ")
+}
+
+func writeLine(output *os.File, line string) {
+	if output != nil {
+		output.WriteString(line + "\n") //nolint
+	}
+	gologger.Silent().Msgf("%s", line)
+}
+
+// text
+
+type textWriter struct {
+	output *os.File
+}
+
+func (w *textWriter) Write(resource *schema.Resource) error {
+	for _, item := range resourceAssets(resource) {
+		writeLine(w.output, item.Value)
+	}
+	return nil
+}
+
+func (w *textWriter) WriteSummary(name, id string, summary []schema.Summary) error {
+	return nil
+}
+
+func (w *textWriter) Close() error {
+	return nil
+}
+
+// json
+
+type jsonWriter struct {
+	output *os.File
+}
+
+func (w *jsonWriter) Write(resource *schema.Resource) error {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	writeLine(w.output, string(data))
+	return nil
+}
+
+func (w *jsonWriter) WriteSummary(name, id string, summary []schema.Summary) error {
+	return nil
+}
+
+func (w *jsonWriter) Close() error {
+	return nil
+}
+
+// csv
+
+var csvHeader = []string{"provider", "id", "service", "region", "resource_id", "type", "asset", "public", "metadata"}
+
+type csvWriter struct {
+	output        *os.File
+	headerWritten bool
+}
+
+func (w *csvWriter) writeRecord(record []string) error {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	writeLine(w.output, strings.TrimSuffix(buffer.String(), "\n"))
+	return nil
+}
+
+func (w *csvWriter) Write(resource *schema.Resource) error {
+	if !w.headerWritten {
+		if err := w.writeRecord(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	for _, item := range resourceAssets(resource) {
+		record := []string{resource.Provider, resource.ID, resource.Service, resource.Region, resource.ResourceID,
+			item.Kind, item.Value, strconv.FormatBool(resource.Public), formatMetadata(resource.Metadata)}
+		if err := w.writeRecord(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvWriter) WriteSummary(name, id string, summary []schema.Summary) error {
+	return nil
+}
+
+func (w *csvWriter) Close() error {
+	return nil
+}
+
+// report
+
+// reportGroup 是报告中一个配置下的所有资产
+type reportGroup struct {
+	Provider string
+	ID       string
+	Summary  []schema.Summary
+	Services []*reportService
+}
+
+// reportService 是报告中一个配置下某个云服务的所有资产
+type reportService struct {
+	Name      string
+	Resources []*schema.Resource
+}
+
+func (g *reportGroup) service(name string) *reportService {
+	for _, service := range g.Services {
+		if service.Name == name {
+			return service
+		}
+	}
+	service := &reportService{Name: name}
+	g.Services = append(g.Services, service)
+	return service
+}
+
+// reportWriter 先收集所有资产，在 Close 时按云服务商、配置和云服务分组生成报告
+type reportWriter struct {
+	output *os.File
+	groups []*reportGroup
+	render func(groups []*reportGroup) (string, error)
+}
+
+func (w *reportWriter) group(name, id string) *reportGroup {
+	for _, group := range w.groups {
+		if group.Provider == name && group.ID == id {
+			return group
+		}
+	}
+	group := &reportGroup{Provider: name, ID: id}
+	w.groups = append(w.groups, group)
+	return group
+}
+
+func (w *reportWriter) Write(resource *schema.Resource) error {
+	service := w.group(resource.Provider, resource.ID).service(resource.Service)
+	service.Resources = append(service.Resources, resource)
+	return nil
+}
+
+func (w *reportWriter) WriteSummary(name, id string, summary []schema.Summary) error {
+	group := w.group(name, id)
+	group.Summary = append(group.Summary, summary...)
+	return nil
+}
+
+func (w *reportWriter) Close() error {
+	report, err := w.render(w.groups)
+	if err != nil {
+		return err
+	}
+	if w.output != nil {
+		_, err = w.output.WriteString(report)
+		return err
+	}
+	gologger.Silent().Msgf("%s", report)
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+const reportTitle = "LC 云资产报告"
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+func serviceName(name string) string {
+	if name == "" {
+		return "其他"
+	}
+	return name
+}
+
+func renderMarkdown(groups []*reportGroup) (string, error) {
+	builder := &strings.Builder{}
+	builder.WriteString("# " + reportTitle + "\n\n")
+	builder.WriteString(fmt.Sprintf("生成时间：%s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+
+	builder.WriteString("## 资产统计\n\n")
+	builder.WriteString("| 云服务商 | 配置 | 服务 | 数量 |\n")
+	builder.WriteString("|:--:|:--:|:--:|:--:|\n")
+	for _, group := range groups {
+		for _, summary := range group.Summary {
+			builder.WriteString(fmt.Sprintf("| %s | %s | %s | %d |\n",
+				markdownEscape(group.Provider), markdownEscape(group.ID), markdownEscape(summary.Service), summary.Count))
+		}
+	}
+
+	for _, group := range groups {
+		builder.WriteString(fmt.Sprintf("\n## %s (%s)\n", group.Provider, group.ID))
+		if len(group.Services) == 0 {
+			builder.WriteString("\n未发现资产\n")
+			continue
+		}
+		for _, service := range group.Services {
+			builder.WriteString(fmt.Sprintf("\n### %s\n\n", serviceName(service.Name)))
+			builder.WriteString("| 区域 | 资源 ID | 类型 | 资产 | 公网 | 附加信息 |\n")
+			builder.WriteString("|:--:|:--:|:--:|:--:|:--:|:--:|\n")
+			for _, resource := range service.Resources {
+				for _, item := range resourceAssets(resource) {
+					builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %t | %s |\n", markdownEscape(resource.Region),
+						markdownEscape(resource.ResourceID), item.Kind, markdownEscape(item.Value), resource.Public,
+						markdownEscape(formatMetadata(resource.Metadata))))
+				}
+			}
+		}
+	}
+	return builder.String(), nil
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"assets":   resourceAssets,
+	"service":  serviceName,
+	"metadata": formatMetadata,
+}).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", "PingFang SC", "Microsoft YaHei", sans-serif; margin: 2em; color: #222; }
+h1 { border-bottom: 2px solid #444; padding-bottom: .3em; }
+table { border-collapse: collapse; margin: 1em 0; min-width: 40em; }
+th, td { border: 1px solid #ccc; padding: .4em .8em; text-align: left; }
+th { background: #f0f0f0; }
+tr:nth-child(even) td { background: #fafafa; }
+.private { color: #888; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+<p>生成时间：{{ .Time }}</p>
+<h2>资产统计</h2>
+<table>
+<tr><th>云服务商</th><th>配置</th><th>服务</th><th>数量</th></tr>
+{{- range $group := .Groups }}{{ range $group.Summary }}
+<tr><td>{{ $group.Provider }}</td><td>{{ $group.ID }}</td><td>{{ .Service }}</td><td>{{ .Count }}</td></tr>
+{{- end }}{{ end }}
+</table>
+{{- range .Groups }}
+<h2>{{ .Provider }} ({{ .ID }})</h2>
+{{- range .Services }}
+<h3>{{ service .Name }}</h3>
+<table>
+<tr><th>区域</th><th>资源 ID</th><th>类型</th><th>资产</th><th>公网</th><th>附加信息</th></tr>
+{{- range $resource := .Resources }}{{ range assets $resource }}
+<tr{{ if not $resource.Public }} class="private"{{ end }}><td>{{ $resource.Region }}</td><td>{{ $resource.ResourceID }}</td><td>{{ .Kind }}</td><td>{{ .Value }}</td><td>{{ $resource.Public }}</td><td>{{ metadata $resource.Metadata }}</td></tr>
+{{- end }}{{ end }}
+</table>
+{{- else }}
+<p>未发现资产</p>
+{{- end }}
+{{- end }}
+</body>
+</html>
+`))
+
+func renderHTML(groups []*reportGroup) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := htmlReport.Execute(buffer, map[string]interface{}{
+		"Title":  reportTitle,
+		"Time":   time.Now().Format("2006-01-02 15:04:05"),
+		"Groups": groups,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
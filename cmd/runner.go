@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"github.com/projectdiscovery/gologger"
@@ -62,9 +61,17 @@ func (r *Runner) Enumerate() {
 		if err != nil {
 			gologger.Fatal().Msgf("无法创建导出的文件 %s: %s\n", r.options.Output, err)
 		}
+		defer outputFile.Close()
 		output = outputFile
 	}
-	builder := &bytes.Buffer{}
+	format := r.options.Format
+	if r.options.JSON {
+		format = formatJSON
+	}
+	writer, err := newOutputWriter(format, output)
+	if err != nil {
+		gologger.Fatal().Msgf("%s", err)
+	}
 	schema.SetThreads(r.options.Threads)
 	for _, provider := range inventory.Providers {
 		gologger.Info().Msgf("正在列出 %s (%s) 的资产\n", provider.Name(), provider.ID())
@@ -75,31 +82,17 @@ func (r *Runner) Enumerate() {
 		}
 		var Count int
 		for _, instance := range instances.GetItems() {
-			builder.Reset()
-			if instance.DNSName != "" {
-				Count++
-				builder.WriteString(instance.DNSName)
-				builder.WriteRune('\n')
-				output.WriteString(builder.String()) //nolint
-				builder.Reset()
-				gologger.Silent().Msgf("%s", instance.DNSName)
-			}
-			if instance.PublicIPv4 != "" {
-				Count++
-				builder.WriteString(instance.PublicIPv4)
-				builder.WriteRune('\n')
-				output.WriteString(builder.String())
-				builder.Reset()
-				gologger.Silent().Msgf("%s", instance.PublicIPv4)
+			if r.options.ExcludePrivate && !instance.Public {
+				continue
 			}
-			if instance.PrivateIpv4 != "" && !r.options.ExcludePrivate {
-				Count++
-				builder.WriteString(instance.PrivateIpv4)
-				builder.WriteRune('\n')
-				output.WriteString(builder.String())
-				builder.Reset()
-				gologger.Silent().Msgf("%s", instance.PrivateIpv4)
+			if err := writer.Write(instance); err != nil {
+				gologger.Debug().Msgf("无法输出资产 %v: %s", instance, err)
+				continue
 			}
+			Count++
+		}
+		if err := writer.WriteSummary(provider.Name(), provider.ID(), instances.GetSummary()); err != nil {
+			gologger.Debug().Msgf("无法输出 %s (%s) 的资产统计: %s", provider.Name(), provider.ID(), err)
 		}
 		if Count == 0 {
 			gologger.Info().Msgf("在 %s (%s) 下未发现资产，这一般是由于权限不足或没有资产。", provider.Name(), provider.ID())
@@ -108,4 +101,7 @@ func (r *Runner) Enumerate() {
 			fmt.Println()
 		}
 	}
+	if err := writer.Close(); err != nil {
+		gologger.Error().Msgf("无法生成导出的结果: %s\n", err)
+	}
 }
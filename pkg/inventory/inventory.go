@@ -3,12 +3,23 @@ package inventory
 import (
 	"fmt"
 	"github.com/wgpsec/lc/pkg/providers/aliyun"
+	"github.com/wgpsec/lc/pkg/providers/aws"
+	"github.com/wgpsec/lc/pkg/providers/azure"
 	"github.com/wgpsec/lc/pkg/providers/baidu"
+	"github.com/wgpsec/lc/pkg/providers/cloudflare"
+	"github.com/wgpsec/lc/pkg/providers/gcp"
 	"github.com/wgpsec/lc/pkg/providers/huawei"
+	"github.com/wgpsec/lc/pkg/providers/jdcloud"
+	"github.com/wgpsec/lc/pkg/providers/kingsoft"
+	"github.com/wgpsec/lc/pkg/providers/kubernetes"
 	"github.com/wgpsec/lc/pkg/providers/liantong"
+	"github.com/wgpsec/lc/pkg/providers/openstack"
 	"github.com/wgpsec/lc/pkg/providers/qiniu"
+	"github.com/wgpsec/lc/pkg/providers/s3"
 	"github.com/wgpsec/lc/pkg/providers/tencent"
 	"github.com/wgpsec/lc/pkg/providers/tianyi"
+	"github.com/wgpsec/lc/pkg/providers/ucloud"
+	"github.com/wgpsec/lc/pkg/providers/volcengine"
 	"github.com/wgpsec/lc/pkg/providers/yidong"
 	"github.com/wgpsec/lc/pkg/schema"
 	"github.com/wgpsec/lc/utils"
@@ -53,6 +64,28 @@ func nameToProvider(value string, block schema.OptionBlock) (schema.Provider, er
 		return qiniu.New(block)
 	case utils.YiDong:
 		return yidong.New(block)
+	case utils.Aws:
+		return aws.New(block)
+	case utils.Volcengine:
+		return volcengine.New(block)
+	case utils.JDCloud:
+		return jdcloud.New(block)
+	case utils.Kingsoft:
+		return kingsoft.New(block)
+	case utils.UCloud:
+		return ucloud.New(block)
+	case utils.Azure:
+		return azure.New(block)
+	case utils.GCP:
+		return gcp.New(block)
+	case utils.Cloudflare:
+		return cloudflare.New(block)
+	case utils.Kubernetes:
+		return kubernetes.New(block)
+	case utils.OpenStack:
+		return openstack.New(block)
+	case utils.S3:
+		return s3.New(block)
 	default:
 		return nil, fmt.Errorf("发现无效的云服务商名: %s", value)
 	}
@@ -0,0 +1,63 @@
+package aliyun
+
+import (
+	"context"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+	"sync"
+)
+
+func (d *loadBalancerProvider) GetAlbResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeAlbInstances)
+	return d.list, nil
+}
+
+func (d *loadBalancerProvider) describeAlbInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		albClient *alb.Client
+		response  *alb.ListLoadBalancersResponse
+	)
+	for region := range ch {
+		albClient, err = alb.NewClientWithOptions(region, sdk.NewConfig(), d.config.credential())
+		if err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的阿里云 ALB 资源信息", region)
+		request := alb.CreateListLoadBalancersRequest()
+		request.MaxResults = requests.NewInteger(100)
+		for {
+			response, err = albClient.ListLoadBalancers(request)
+			if err != nil {
+				break
+			}
+			if len(response.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 ALB 资源", region, len(response.LoadBalancers))
+			}
+			for _, loadBalancer := range response.LoadBalancers {
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "ALB",
+					Region:     region,
+					ResourceID: loadBalancer.LoadBalancerId,
+					DNSName:    loadBalancer.DNSName,
+					Public:     strings.EqualFold(loadBalancer.AddressType, "Internet"),
+				})
+			}
+			if response.NextToken == "" {
+				gologger.Debug().Msgf("NextToken 为空，已终止获取")
+				break
+			}
+			gologger.Debug().Msgf("NextToken 不为空，正在获取下一页数据")
+			request.NextToken = response.NextToken
+		}
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
@@ -29,6 +30,14 @@ type providerConfig struct {
 	okST            bool
 }
 
+// credential 根据是否存在 session token 返回临时或永久访问凭证
+func (c providerConfig) credential() auth.Credential {
+	if c.okST {
+		return credentials.NewStsTokenCredential(c.accessKeyID, c.accessKeySecret, c.sessionToken)
+	}
+	return credentials.NewAccessKeyCredential(c.accessKeyID, c.accessKeySecret)
+}
+
 func New(options schema.OptionBlock) (*Provider, error) {
 	var (
 		region    = "cn-beijing"
@@ -141,10 +150,61 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 	}
 	gologger.Info().Msgf("获取到 %d 条阿里云 OSS 信息", len(buckets.GetItems()))
 
+	lbProvider := &loadBalancerProvider{id: p.id, provider: p.provider, ecsRegions: p.ecsRegions, config: p.config}
+	slbList, err := lbProvider.GetSlbResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 SLB 信息", len(slbList.GetItems()))
+	albList, err := lbProvider.GetAlbResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 ALB 信息", len(albList.GetItems()))
+	nlbList, err := lbProvider.GetNlbResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 NLB 信息", len(nlbList.GetItems()))
+
+	vpcProvider := &vpcProvider{id: p.id, provider: p.provider, ecsRegions: p.ecsRegions, config: p.config}
+	eipList, err := vpcProvider.GetEipResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 EIP 信息", len(eipList.GetItems()))
+	natList, err := vpcProvider.GetNatResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 NAT 网关信息", len(natList.GetItems()))
+
+	dnsProvider := &dnsProvider{id: p.id, provider: p.provider, config: p.config}
+	dnsList, err := dnsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条阿里云 DNS 解析信息", len(dnsList.GetItems()))
+
 	finalList := schema.NewResources()
 	finalList.Merge(ecsList)
 	finalList.Merge(rdsList)
 	finalList.Merge(buckets)
+	finalList.Merge(slbList)
+	finalList.Merge(albList)
+	finalList.Merge(nlbList)
+	finalList.Merge(eipList)
+	finalList.Merge(natList)
+	finalList.Merge(dnsList)
+	finalList.AddSummary("ECS", len(ecsList.GetItems()))
+	finalList.AddSummary("RDS", len(rdsList.GetItems()))
+	finalList.AddSummary("OSS", len(buckets.GetItems()))
+	finalList.AddSummary("SLB", len(slbList.GetItems()))
+	finalList.AddSummary("ALB", len(albList.GetItems()))
+	finalList.AddSummary("NLB", len(nlbList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("NAT", len(natList.GetItems()))
+	finalList.AddSummary("DNS", len(dnsList.GetItems()))
 	return finalList, nil
 }
 
@@ -154,3 +214,12 @@ func (p *Provider) Name() string {
 func (p *Provider) ID() string {
 	return p.id
 }
+
+// regionIds 返回所有 ECS 区域的 ID
+func regionIds(ecsRegions *ecs.DescribeRegionsResponse) []string {
+	var regions []string
+	for _, region := range ecsRegions.Regions.Region {
+		regions = append(regions, region.RegionId)
+	}
+	return regions
+}
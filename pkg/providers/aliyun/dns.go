@@ -0,0 +1,72 @@
+package aliyun
+
+import (
+	"context"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+)
+
+type dnsProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+}
+
+func (d *dnsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取阿里云 DNS 资源信息")
+	dnsClient, err := alidns.NewClientWithOptions("cn-hangzhou", sdk.NewConfig(), d.config.credential())
+	if err != nil {
+		return nil, err
+	}
+	request := alidns.CreateDescribeDomainsRequest()
+	request.PageSize = requests.NewInteger(100)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response, err := dnsClient.DescribeDomains(request)
+		if err != nil {
+			break
+		}
+		for _, domain := range response.Domains.Domain {
+			records := d.describeDomainRecords(dnsClient, domain.DomainName)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", domain.DomainName, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "DNS"}, domain.DomainName)
+		}
+		if int64(pageNumber)*100 >= response.TotalCount {
+			break
+		}
+	}
+	return list, nil
+}
+
+// describeDomainRecords 按照完整的域名聚合域名下所有 A、AAAA 和 CNAME 解析记录
+func (d *dnsProvider) describeDomainRecords(dnsClient *alidns.Client, domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	request := alidns.CreateDescribeDomainRecordsRequest()
+	request.DomainName = domainName
+	request.PageSize = requests.NewInteger(500)
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response, err := dnsClient.DescribeDomainRecords(request)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+			break
+		}
+		for _, record := range response.DomainRecords.Record {
+			fqdn := domainName
+			if record.RR != "@" {
+				fqdn = record.RR + "." + domainName
+			}
+			records.Add(fqdn, record.Type, record.Value, record.RecordId)
+		}
+		if int64(pageNumber)*500 >= response.TotalCount {
+			break
+		}
+	}
+	return records
+}
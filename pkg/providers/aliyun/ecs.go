@@ -20,33 +20,7 @@ type instanceProvider struct {
 var ecsList = schema.NewResources()
 
 func (d *instanceProvider) GetEcsResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		err     error
-		wg      sync.WaitGroup
-		regions []string
-	)
-	threads = schema.GetThreads()
-
-	for _, region := range d.ecsRegions.Regions.Region {
-		regions = append(regions, region.RegionId)
-	}
-
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.describeEcsInstances(taskCh, &wg)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range regions {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeEcsInstances)
 	return ecsList, nil
 }
 
@@ -84,24 +58,35 @@ func (d *instanceProvider) describeEcsInstances(ch <-chan string, wg *sync.WaitG
 			}
 			for _, instance := range response.Instances.Instance {
 				var (
-					ipv4        []string
-					privateIPv4 string
+					privateIPv4 []string
+					eips        []string
+					ipv6        []string
 				)
-				if len(instance.PublicIpAddress.IpAddress) > 0 {
-					ipv4 = append(ipv4, instance.PublicIpAddress.IpAddress...)
-				}
-				if len(instance.NetworkInterfaces.NetworkInterface) > 0 && len(instance.NetworkInterfaces.NetworkInterface[0].PrivateIpSets.PrivateIpSet) > 0 {
-					privateIPv4 = instance.NetworkInterfaces.NetworkInterface[0].PrivateIpSets.PrivateIpSet[0].PrivateIpAddress
+				for _, networkInterface := range instance.NetworkInterfaces.NetworkInterface {
+					for _, privateIpSet := range networkInterface.PrivateIpSets.PrivateIpSet {
+						privateIPv4 = append(privateIPv4, privateIpSet.PrivateIpAddress)
+					}
+					for _, ipv6Set := range networkInterface.Ipv6Sets.Ipv6Set {
+						ipv6 = append(ipv6, ipv6Set.Ipv6Address)
+					}
 				}
-				for _, v := range ipv4 {
-					ecsList.Append(&schema.Resource{
-						ID:          d.id,
-						Provider:    d.provider,
-						PublicIPv4:  v,
-						PrivateIpv4: privateIPv4,
-						Public:      v != "",
-					})
+				privateIPv4 = append(privateIPv4, instance.VpcAttributes.PrivateIpAddress.IpAddress...)
+				privateIPv4 = append(privateIPv4, instance.InnerIpAddress.IpAddress...)
+				if instance.EipAddress.IpAddress != "" {
+					eips = append(eips, instance.EipAddress.IpAddress)
 				}
+				ecsList.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "ECS",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					PublicIPv4s:  instance.PublicIpAddress.IpAddress,
+					EIPs:         eips,
+					PrivateIpv4s: privateIPv4,
+					IPv6s:        ipv6,
+					Public:       len(instance.PublicIpAddress.IpAddress) > 0 || len(eips) > 0 || len(ipv6) > 0,
+				})
 			}
 			if response.NextToken == "" {
 				gologger.Debug().Msgf("NextToken 为空，已终止获取")
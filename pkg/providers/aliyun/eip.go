@@ -0,0 +1,75 @@
+package aliyun
+
+import (
+	"context"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type vpcProvider struct {
+	id         string
+	provider   string
+	config     providerConfig
+	ecsRegions *ecs.DescribeRegionsResponse
+	list       *schema.Resources
+}
+
+func (d *vpcProvider) GetEipResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeEipAddresses)
+	return d.list, nil
+}
+
+func (d *vpcProvider) describeEipAddresses(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		vpcClient *vpc.Client
+		response  *vpc.DescribeEipAddressesResponse
+	)
+	for region := range ch {
+		vpcClient, err = vpc.NewClientWithOptions(region, sdk.NewConfig(), d.config.credential())
+		if err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的阿里云 EIP 资源信息", region)
+		request := vpc.CreateDescribeEipAddressesRequest()
+		request.PageSize = requests.NewInteger(100)
+		for pageNumber := 1; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+			response, err = vpcClient.DescribeEipAddresses(request)
+			if err != nil {
+				break
+			}
+			if len(response.EipAddresses.EipAddress) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(response.EipAddresses.EipAddress))
+			}
+			for _, eip := range response.EipAddresses.EipAddress {
+				metadata := map[string]string{"status": eip.Status}
+				if eip.InstanceId != "" {
+					metadata["instance_id"] = eip.InstanceId
+					metadata["instance_type"] = eip.InstanceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     region,
+					ResourceID: eip.AllocationId,
+					PublicIPv4: eip.IpAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if pageNumber*100 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
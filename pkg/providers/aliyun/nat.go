@@ -0,0 +1,139 @@
+package aliyun
+
+import (
+	"context"
+	"fmt"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+	"sync"
+)
+
+func (d *vpcProvider) GetNatResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeNatGateways)
+	return d.list, nil
+}
+
+func (d *vpcProvider) describeNatGateways(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		vpcClient *vpc.Client
+		response  *vpc.DescribeNatGatewaysResponse
+	)
+	for region := range ch {
+		vpcClient, err = vpc.NewClientWithOptions(region, sdk.NewConfig(), d.config.credential())
+		if err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的阿里云 NAT 网关资源信息", region)
+		request := vpc.CreateDescribeNatGatewaysRequest()
+		request.PageSize = requests.NewInteger(50)
+		for pageNumber := 1; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+			response, err = vpcClient.DescribeNatGateways(request)
+			if err != nil {
+				break
+			}
+			if len(response.NatGateways.NatGateway) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 NAT 网关资源", region, len(response.NatGateways.NatGateway))
+			}
+			for _, natGateway := range response.NatGateways.NatGateway {
+				var ips []string
+				for _, ip := range natGateway.IpLists.IpList {
+					ips = append(ips, ip.IpAddress)
+				}
+				dnat := d.describeForwardTableEntries(vpcClient, natGateway.ForwardTableIds.ForwardTableId)
+				snat := d.describeSnatTableEntries(vpcClient, natGateway.SnatTableIds.SnatTableId)
+				for ip := range dnat {
+					ips = append(ips, ip)
+				}
+				for _, ip := range ips {
+					metadata := map[string]string{}
+					if len(dnat[ip]) > 0 {
+						metadata["dnat"] = strings.Join(dnat[ip], ", ")
+					}
+					if len(snat[ip]) > 0 {
+						metadata["snat"] = strings.Join(snat[ip], ", ")
+					}
+					d.list.Append(&schema.Resource{
+						ID:         d.id,
+						Provider:   d.provider,
+						Service:    "NAT",
+						Region:     region,
+						ResourceID: natGateway.NatGatewayId,
+						PublicIPv4: ip,
+						Public:     true,
+						Metadata:   metadata,
+					})
+				}
+			}
+			if pageNumber*50 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
+
+// describeForwardTableEntries 返回每个公网 IP 对应的 DNAT 端口映射，例如 tcp 80 -> 192.168.0.1:8080
+func (d *vpcProvider) describeForwardTableEntries(vpcClient *vpc.Client, forwardTableIds []string) map[string][]string {
+	dnat := make(map[string][]string)
+	for _, forwardTableId := range forwardTableIds {
+		request := vpc.CreateDescribeForwardTableEntriesRequest()
+		request.ForwardTableId = forwardTableId
+		request.PageSize = requests.NewInteger(50)
+		for pageNumber := 1; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+			response, err := vpcClient.DescribeForwardTableEntries(request)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 的 DNAT 条目: %s", forwardTableId, err)
+				break
+			}
+			for _, entry := range response.ForwardTableEntries.ForwardTableEntry {
+				dnat[entry.ExternalIp] = append(dnat[entry.ExternalIp], fmt.Sprintf("%s %s -> %s:%s",
+					strings.ToLower(entry.IpProtocol), entry.ExternalPort, entry.InternalIp, entry.InternalPort))
+			}
+			if pageNumber*50 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return dnat
+}
+
+// describeSnatTableEntries 返回每个公网 IP 对应的 SNAT 源网段
+func (d *vpcProvider) describeSnatTableEntries(vpcClient *vpc.Client, snatTableIds []string) map[string][]string {
+	snat := make(map[string][]string)
+	for _, snatTableId := range snatTableIds {
+		request := vpc.CreateDescribeSnatTableEntriesRequest()
+		request.SnatTableId = snatTableId
+		request.PageSize = requests.NewInteger(50)
+		for pageNumber := 1; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+			response, err := vpcClient.DescribeSnatTableEntries(request)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 的 SNAT 条目: %s", snatTableId, err)
+				break
+			}
+			for _, entry := range response.SnatTableEntries.SnatTableEntry {
+				source := entry.SourceCIDR
+				if source == "" {
+					source = entry.SourceVSwitchId
+				}
+				// 一个 SNAT 条目可能使用多个以逗号分隔的公网 IP
+				for _, ip := range strings.Split(entry.SnatIp, ",") {
+					snat[ip] = append(snat[ip], source)
+				}
+			}
+			if pageNumber*50 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return snat
+}
@@ -0,0 +1,80 @@
+package aliyun
+
+import (
+	"context"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+	"sync"
+)
+
+func (d *loadBalancerProvider) GetNlbResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeNlbInstances)
+	return d.list, nil
+}
+
+func (d *loadBalancerProvider) describeNlbInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		nlbClient *nlb.Client
+		response  *nlb.ListLoadBalancersResponse
+	)
+	for region := range ch {
+		nlbClient, err = nlb.NewClientWithOptions(region, sdk.NewConfig(), d.config.credential())
+		if err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的阿里云 NLB 资源信息", region)
+		request := nlb.CreateListLoadBalancersRequest()
+		request.MaxResults = requests.NewInteger(100)
+		for {
+			response, err = nlbClient.ListLoadBalancers(request)
+			if err != nil {
+				break
+			}
+			if len(response.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 NLB 资源", region, len(response.LoadBalancers))
+			}
+			for _, loadBalancer := range response.LoadBalancers {
+				var (
+					publicIPv4  []string
+					privateIPv4 []string
+					ipv6        []string
+				)
+				for _, zoneMapping := range loadBalancer.ZoneMappings {
+					for _, address := range zoneMapping.LoadBalancerAddresses {
+						if address.PublicIPv4Address != "" {
+							publicIPv4 = append(publicIPv4, address.PublicIPv4Address)
+						}
+						privateIPv4 = append(privateIPv4, address.PrivateIPv4Address)
+						ipv6 = append(ipv6, address.Ipv6Address)
+					}
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "NLB",
+					Region:       region,
+					ResourceID:   loadBalancer.LoadBalancerId,
+					DNSName:      loadBalancer.DNSName,
+					PublicIPv4s:  publicIPv4,
+					PrivateIpv4s: privateIPv4,
+					IPv6s:        ipv6,
+					Public:       strings.EqualFold(loadBalancer.AddressType, "Internet"),
+				})
+			}
+			if response.NextToken == "" {
+				gologger.Debug().Msgf("NextToken 为空，已终止获取")
+				break
+			}
+			gologger.Debug().Msgf("NextToken 不为空，正在获取下一页数据")
+			request.NextToken = response.NextToken
+		}
+	}
+	return err
+}
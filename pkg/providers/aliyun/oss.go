@@ -30,10 +30,13 @@ func (d *ossProvider) GetResource(ctx context.Context) (*schema.Resources, error
 			endpointBuilder.WriteString(".oss-" + bucket.Region)
 			endpointBuilder.WriteString(".aliyuncs.com")
 			ossList.Append(&schema.Resource{
-				ID:       d.id,
-				Public:   true,
-				DNSName:  endpointBuilder.String(),
-				Provider: d.provider,
+				ID:         d.id,
+				Public:     true,
+				DNSName:    endpointBuilder.String(),
+				Provider:   d.provider,
+				Service:    "OSS",
+				Region:     bucket.Region,
+				ResourceID: bucket.Name,
 			})
 		}
 		if !response.IsTruncated {
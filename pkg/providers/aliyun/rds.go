@@ -28,33 +28,15 @@ var rdsList = schema.NewResources()
 
 func (d *dbInstanceProvider) GetRdsResource(ctx context.Context) (*schema.Resources, error) {
 	var (
-		threads int
 		err     error
-		wg      sync.WaitGroup
 		regions []string
 	)
-	threads = schema.GetThreads()
-
 	for _, region := range d.rdsRegions.Regions.RDSRegion {
 		regions = append(regions, region.RegionId)
 	}
 	regions = utils.RemoveRepeatedElement(regions)
 
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.describeRdsInstances(taskCh, &wg)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range regions {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
+	schema.RunWorkers(regions, d.describeRdsInstances)
 	err = d.GetRdsConnectionString(ctx)
 	if err != nil {
 		return nil, err
@@ -152,6 +134,9 @@ func (d *dbInstanceProvider) GetRdsConnectionString(ctx context.Context) error {
 		rdsList.Append(&schema.Resource{
 			ID:          d.id,
 			Provider:    d.provider,
+			Service:     "RDS",
+			Region:      dbInstance.region,
+			ResourceID:  dbInstance.dbId,
 			PublicIPv4:  public,
 			PrivateIpv4: private,
 			Public:      public != "",
@@ -0,0 +1,69 @@
+package aliyun
+
+import (
+	"context"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type loadBalancerProvider struct {
+	id         string
+	provider   string
+	config     providerConfig
+	ecsRegions *ecs.DescribeRegionsResponse
+	list       *schema.Resources
+}
+
+func (d *loadBalancerProvider) GetSlbResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regionIds(d.ecsRegions), d.describeSlbInstances)
+	return d.list, nil
+}
+
+func (d *loadBalancerProvider) describeSlbInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		slbClient *slb.Client
+		response  *slb.DescribeLoadBalancersResponse
+	)
+	for region := range ch {
+		slbClient, err = slb.NewClientWithOptions(region, sdk.NewConfig(), d.config.credential())
+		if err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的阿里云 SLB 资源信息", region)
+		request := slb.CreateDescribeLoadBalancersRequest()
+		request.PageSize = requests.NewInteger(100)
+		for pageNumber := 1; ; pageNumber++ {
+			request.PageNumber = requests.NewInteger(pageNumber)
+			response, err = slbClient.DescribeLoadBalancers(request)
+			if err != nil {
+				break
+			}
+			if len(response.LoadBalancers.LoadBalancer) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 SLB 资源", region, len(response.LoadBalancers.LoadBalancer))
+			}
+			for _, loadBalancer := range response.LoadBalancers.LoadBalancer {
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "SLB",
+					Region:     region,
+					ResourceID: loadBalancer.LoadBalancerId,
+					PublicIPv4: loadBalancer.Address,
+					Public:     loadBalancer.AddressType == "internet",
+				})
+			}
+			if pageNumber*100 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
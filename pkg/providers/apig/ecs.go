@@ -0,0 +1,97 @@
+package apig
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// ECSProvider 获取所有项目下的 ECS 云服务器，服务地址的格式为 ecs.{region}.{Endpoint}
+type ECSProvider struct {
+	ID       string
+	Provider string
+	Name     string // Name 为日志中显示的云服务商名称，例如华为云
+	Endpoint string
+	Client   *Client
+	Projects []Project
+
+	list *schema.Resources
+}
+
+type listServersResponse struct {
+	Count   int `json:"count"`
+	Servers []struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		Addresses map[string][]struct {
+			Addr    string `json:"addr"`
+			Version string `json:"version"`
+			Type    string `json:"OS-EXT-IPS:type"`
+		} `json:"addresses"`
+	} `json:"servers"`
+}
+
+func (d *ECSProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.Projects, d.listServers)
+	return d.list, nil
+}
+
+func (d *ECSProvider) listServers(ch <-chan Project, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的%s ECS 资源信息", item.Name, d.Name)
+		// offset 为页码，从 1 开始
+		for offset := 1; ; offset++ {
+			var response listServersResponse
+			query := url.Values{"offset": {strconv.Itoa(offset)}, "limit": {"100"}}
+			err = d.Client.Get("ecs."+item.Region+"."+d.Endpoint, "/v1/"+item.ID+"/cloudservers/detail", query, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 ECS 资源: %s", item.Name, err)
+				break
+			}
+			if len(response.Servers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 ECS 资源", item.Name, len(response.Servers))
+			}
+			for _, server := range response.Servers {
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+					ipv6s        []string
+				)
+				for _, addresses := range server.Addresses {
+					for _, address := range addresses {
+						switch {
+						case address.Version == "6":
+							ipv6s = append(ipv6s, address.Addr)
+						case address.Type == "floating":
+							publicIPv4s = append(publicIPv4s, address.Addr)
+						default:
+							privateIPv4s = append(privateIPv4s, address.Addr)
+						}
+					}
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.ID,
+					Provider:     d.Provider,
+					Service:      "ECS",
+					Region:       item.Region,
+					ResourceID:   server.ID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					IPv6s:        ipv6s,
+					Public:       len(publicIPv4s) > 0,
+					Metadata:     map[string]string{"name": server.Name},
+				})
+			}
+			if offset*100 >= response.Count {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,149 @@
+package apig
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient 把所有 https://{host} 请求转发到 handler，handler 可以通过 r.Host 检查服务地址
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	client := NewClient("ak", "sk", "")
+	client.httpClient = &http.Client{Transport: transport}
+	return client
+}
+
+func TestECSProviderUsesEndpoint(t *testing.T) {
+	schema.SetThreads(2)
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "ecs.cn-north-1.cucloud.cn" || r.URL.Path != "/v1/project-1/cloudservers/detail" {
+			t.Errorf("请求的地址不正确: %s%s", r.Host, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), signAlgorithm+" Access=ak,") {
+			t.Errorf("请求没有签名: %s", r.Header.Get("Authorization"))
+		}
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		// 第一页返回 100 条，第二页返回剩余的 1 条
+		count := 100
+		if r.URL.Query().Get("offset") == "2" {
+			count = 1
+		}
+		var servers []map[string]interface{}
+		for i := 0; i < count; i++ {
+			servers = append(servers, map[string]interface{}{
+				"id":   "server-" + r.URL.Query().Get("offset"),
+				"name": "web",
+				"addresses": map[string]interface{}{
+					"vpc-1": []map[string]string{
+						{"addr": "192.168.0.1", "version": "4", "OS-EXT-IPS:type": "fixed"},
+						{"addr": "123.0.0.1", "version": "4", "OS-EXT-IPS:type": "floating"},
+					},
+				},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"count": 101, "servers": servers})
+	})
+
+	provider := &ECSProvider{
+		ID:       "test",
+		Provider: "liantong",
+		Name:     "联通云",
+		Endpoint: "cucloud.cn",
+		Client:   client,
+		Projects: []Project{{ID: "project-1", Name: "cn-north-1", Region: "cn-north-1"}},
+	}
+	list, err := provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(offsets, ",") != "1,2" {
+		t.Errorf("没有按照页码翻页: %v", offsets)
+	}
+	var public, private bool
+	for _, item := range list.GetItems() {
+		if item.Provider != "liantong" || item.Service != "ECS" || item.Region != "cn-north-1" {
+			t.Errorf("资产的来源不正确: %+v", item)
+		}
+		switch {
+		case item.PublicIPv4 == "123.0.0.1" && item.Public:
+			public = true
+		case item.PrivateIpv4 == "192.168.0.1" && !item.Public:
+			private = true
+		}
+	}
+	if !public || !private {
+		t.Errorf("缺少 ECS 的公网或内网地址: %v", list.GetItems())
+	}
+}
+
+func TestEIPProviderFollowsMarker(t *testing.T) {
+	schema.SetThreads(2)
+	var markers []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "vpc.cn-north-4.myhuaweicloud.com" || r.URL.Path != "/v1/project-4/publicips" {
+			t.Errorf("请求的地址不正确: %s%s", r.Host, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		marker := r.URL.Query().Get("marker")
+		markers = append(markers, marker)
+		var publicIPs []map[string]string
+		if marker == "" {
+			for i := 0; i < 100; i++ {
+				publicIPs = append(publicIPs, map[string]string{
+					"id":                "eip-" + string(rune('a'+i%26)) + string(rune('a'+i/26)),
+					"public_ip_address": net.IPv4(123, 0, 1, byte(i)).String(),
+					"status":            "DOWN",
+				})
+			}
+		} else {
+			publicIPs = append(publicIPs, map[string]string{
+				"id":                 "eip-last",
+				"public_ip_address":  "123.0.2.1",
+				"private_ip_address": "192.168.0.1",
+				"status":             "ACTIVE",
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"publicips": publicIPs})
+	})
+
+	provider := &EIPProvider{
+		ID:       "test",
+		Provider: "huawei",
+		Name:     "华为云",
+		Endpoint: "myhuaweicloud.com",
+		Client:   client,
+		Projects: []Project{{ID: "project-4", Name: "cn-north-4", Region: "cn-north-4"}},
+	}
+	list, err := provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(markers) != 2 || markers[1] != "eip-vd" {
+		t.Fatalf("没有按照 marker 翻页: %v", markers)
+	}
+	items := list.GetItems()
+	if len(items) != 101 {
+		t.Fatalf("应该获取到 101 个 EIP，实际为 %d 个", len(items))
+	}
+	last := items[len(items)-1]
+	if last.PublicIPv4 != "123.0.2.1" || last.Metadata["bind_private_ip"] != "192.168.0.1" {
+		t.Errorf("最后一页的 EIP 不正确: %+v", last)
+	}
+}
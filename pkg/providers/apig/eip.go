@@ -0,0 +1,80 @@
+package apig
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"sync"
+)
+
+// EIPProvider 获取所有项目下的弹性公网 IP，服务地址的格式为 vpc.{region}.{Endpoint}
+type EIPProvider struct {
+	ID       string
+	Provider string
+	Name     string // Name 为日志中显示的云服务商名称，例如华为云
+	Endpoint string
+	Client   *Client
+	Projects []Project
+
+	list *schema.Resources
+}
+
+type listPublicIPsResponse struct {
+	PublicIPs []struct {
+		ID                string `json:"id"`
+		PublicIPAddress   string `json:"public_ip_address"`
+		PublicIPv6Address string `json:"public_ipv6_address"`
+		PrivateIPAddress  string `json:"private_ip_address"`
+		Status            string `json:"status"`
+	} `json:"publicips"`
+}
+
+func (d *EIPProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.Projects, d.listPublicIPs)
+	return d.list, nil
+}
+
+func (d *EIPProvider) listPublicIPs(ch <-chan Project, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的%s EIP 资源信息", item.Name, d.Name)
+		query := url.Values{"limit": {"100"}}
+		for {
+			var response listPublicIPsResponse
+			err = d.Client.Get("vpc."+item.Region+"."+d.Endpoint, "/v1/"+item.ID+"/publicips", query, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 EIP 资源: %s", item.Name, err)
+				break
+			}
+			if len(response.PublicIPs) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", item.Name, len(response.PublicIPs))
+			}
+			for _, publicIP := range response.PublicIPs {
+				metadata := map[string]string{"status": publicIP.Status}
+				if publicIP.PrivateIPAddress != "" {
+					metadata["bind_private_ip"] = publicIP.PrivateIPAddress
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.ID,
+					Provider:   d.Provider,
+					Service:    "EIP",
+					Region:     item.Region,
+					ResourceID: publicIP.ID,
+					PublicIPv4: publicIP.PublicIPAddress,
+					IPv6s:      []string{publicIP.PublicIPv6Address},
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			// 返回的数量小于 limit 时说明已经是最后一页
+			if len(response.PublicIPs) < 100 {
+				break
+			}
+			query.Set("marker", response.PublicIPs[len(response.PublicIPs)-1].ID)
+		}
+	}
+	return err
+}
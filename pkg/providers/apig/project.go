@@ -0,0 +1,39 @@
+package apig
+
+import (
+	"strings"
+)
+
+// Project 是 IAM 项目，每个区域都有一个默认项目，API 路径中需要使用项目 ID
+type Project struct {
+	ID     string
+	Name   string
+	Region string
+}
+
+type listProjectsResponse struct {
+	Projects []struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	} `json:"projects"`
+}
+
+// ListProjects 通过 iamHost 返回当前凭证可以访问的所有区域项目
+func ListProjects(client *Client, iamHost string) ([]Project, error) {
+	var response listProjectsResponse
+	if err := client.Get(iamHost, "/v3/auth/projects", nil, &response); err != nil {
+		return nil, err
+	}
+	var projects []Project
+	for _, item := range response.Projects {
+		// MOS 是内置项目，不对应任何区域
+		if !item.Enabled || item.Name == "MOS" {
+			continue
+		}
+		// 子项目的名称格式为 {region}_{name}
+		region := strings.SplitN(item.Name, "_", 2)[0]
+		projects = append(projects, Project{ID: item.ID, Name: item.Name, Region: region})
+	}
+	return projects, nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id       string
+	provider string
+	session  *session.Session
+	regions  []string
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	accessKeySecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	sessionToken, okST := options.GetMetadata(utils.SessionToken)
+
+	if okST {
+		gologger.Debug().Msg("找到 AWS 临时访问凭证")
+	} else {
+		gologger.Debug().Msg("找到 AWS 永久访问凭证")
+	}
+
+	region, ok := options.GetMetadata(utils.Region)
+	if !ok {
+		region = "us-east-1"
+	}
+	config := aws.NewConfig().
+		WithRegion(region).
+		WithCredentials(credentials.NewStaticCredentials(accessKeyID, accessKeySecret, sessionToken))
+
+	// 配置 endpoint 后所有服务都会请求这个地址，例如 moto 等本地模拟服务
+	endpoint, okEndpoint := options.GetMetadata(utils.Endpoint)
+	if okEndpoint {
+		config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	awsSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// regions
+	var regions []string
+	if okEndpoint {
+		regions = []string{region}
+	} else {
+		ec2Client := ec2.New(awsSession)
+		response, err := ec2Client.DescribeRegions(&ec2.DescribeRegionsInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range response.Regions {
+			regions = append(regions, aws.StringValue(item.RegionName))
+		}
+	}
+
+	return &Provider{id: id, provider: utils.Aws, session: awsSession, regions: regions}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	ec2Provider := &ec2Provider{id: p.id, provider: p.provider, session: p.session, regions: p.regions}
+	ec2List, err := ec2Provider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 AWS EC2 信息", len(ec2List.GetItems()))
+
+	elbProvider := &elbProvider{id: p.id, provider: p.provider, session: p.session, regions: p.regions}
+	elbList, err := elbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 AWS ELB 信息", len(elbList.GetItems()))
+
+	s3Provider := &s3Provider{id: p.id, provider: p.provider, session: p.session}
+	s3List, err := s3Provider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 AWS S3 信息", len(s3List.GetItems()))
+
+	rdsProvider := &rdsProvider{id: p.id, provider: p.provider, session: p.session, regions: p.regions}
+	rdsList, err := rdsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 AWS RDS 信息", len(rdsList.GetItems()))
+
+	route53Provider := &route53Provider{id: p.id, provider: p.provider, session: p.session}
+	route53List, err := route53Provider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 AWS Route 53 解析信息", len(route53List.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(ec2List)
+	finalList.Merge(elbList)
+	finalList.Merge(s3List)
+	finalList.Merge(rdsList)
+	finalList.Merge(route53List)
+	finalList.AddSummary("EC2", len(ec2List.GetItems()))
+	finalList.AddSummary("ELB", len(elbList.GetItems()))
+	finalList.AddSummary("S3", len(s3List.GetItems()))
+	finalList.AddSummary("RDS", len(rdsList.GetItems()))
+	finalList.AddSummary("Route53", len(route53List.GetItems()))
+	return finalList, nil
+}
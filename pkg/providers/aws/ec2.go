@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type ec2Provider struct {
+	id       string
+	provider string
+	session  *session.Session
+	regions  []string
+	list     *schema.Resources
+}
+
+func (d *ec2Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeInstances)
+	return d.list, nil
+}
+
+func (d *ec2Provider) describeInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 AWS EC2 资源信息", region)
+		ec2Client := ec2.New(d.session, aws.NewConfig().WithRegion(region))
+		err = ec2Client.DescribeInstancesPages(&ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			var count int
+			for _, reservation := range page.Reservations {
+				count += len(reservation.Instances)
+			}
+			if count > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EC2 资源", region, count)
+			}
+			for _, reservation := range page.Reservations {
+				for _, instance := range reservation.Instances {
+					var (
+						publicIPv4s  = []string{aws.StringValue(instance.PublicIpAddress)}
+						privateIPv4s = []string{aws.StringValue(instance.PrivateIpAddress)}
+						ipv6s        []string
+					)
+					for _, networkInterface := range instance.NetworkInterfaces {
+						for _, address := range networkInterface.PrivateIpAddresses {
+							privateIPv4s = append(privateIPv4s, aws.StringValue(address.PrivateIpAddress))
+							if address.Association != nil {
+								publicIPv4s = append(publicIPv4s, aws.StringValue(address.Association.PublicIp))
+							}
+						}
+						for _, address := range networkInterface.Ipv6Addresses {
+							ipv6s = append(ipv6s, aws.StringValue(address.Ipv6Address))
+						}
+					}
+					d.list.Append(&schema.Resource{
+						ID:           d.id,
+						Provider:     d.provider,
+						Service:      "EC2",
+						Region:       region,
+						ResourceID:   aws.StringValue(instance.InstanceId),
+						DNSName:      aws.StringValue(instance.PublicDnsName),
+						PublicIPv4s:  publicIPv4s,
+						PrivateIpv4s: privateIPv4s,
+						IPv6s:        ipv6s,
+						Public:       instance.PublicIpAddress != nil || len(ipv6s) > 0,
+					})
+				}
+			}
+			return true
+		})
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下的 EC2 资源: %s", region, err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"testing"
+)
+
+func TestEC2SplitsEveryAddress(t *testing.T) {
+	schema.SetThreads(2)
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("Action") != "DescribeInstances" {
+			t.Errorf("未预期的请求: %s %s %v", r.Method, r.URL, r.PostForm)
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `<DescribeInstancesResponse><reservationSet><item><instancesSet>`+
+			`<item><instanceId>i-web</instanceId>`+
+			`<privateIpAddress>10.0.0.10</privateIpAddress>`+
+			`<ipAddress>54.1.1.1</ipAddress>`+
+			`<dnsName>ec2-54-1-1-1.us-west-2.compute.amazonaws.com</dnsName>`+
+			`<networkInterfaceSet><item>`+
+			`<privateIpAddressesSet>`+
+			`<item><privateIpAddress>10.0.0.10</privateIpAddress><association><publicIp>54.1.1.1</publicIp></association></item>`+
+			`<item><privateIpAddress>10.0.0.11</privateIpAddress><association><publicIp>54.1.1.2</publicIp></association></item>`+
+			`<item><privateIpAddress>10.0.0.12</privateIpAddress></item>`+
+			`</privateIpAddressesSet>`+
+			`<ipv6AddressesSet><item><ipv6Address>2600:1f14::10</ipv6Address></item></ipv6AddressesSet>`+
+			`</item></networkInterfaceSet></item>`+
+			`<item><instanceId>i-db</instanceId><privateIpAddress>10.0.1.10</privateIpAddress></item>`+
+			`</instancesSet></item></reservationSet></DescribeInstancesResponse>`)
+	})
+	ec2Provider := &ec2Provider{id: "test", provider: utils.Aws, session: provider.session, regions: provider.regions}
+	list, err := ec2Provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		for _, address := range []string{item.DNSName, item.PublicIPv4, item.PrivateIpv4, item.PublicIPv6} {
+			if address != "" {
+				items[address] = item
+			}
+		}
+	}
+	if len(items) != 8 {
+		t.Fatalf("应该得到 1 个域名、2 个公网 IP、4 个内网 IP 和 1 个 IPv6 地址，实际为 %d 个: %v", len(items), items)
+	}
+	for _, address := range []string{"ec2-54-1-1-1.us-west-2.compute.amazonaws.com", "54.1.1.1", "54.1.1.2", "2600:1f14::10"} {
+		if item := items[address]; item == nil || !item.Public || item.ResourceID != "i-web" || item.Region != "us-west-2" {
+			t.Errorf("%s 应该是 i-web 的公网地址: %+v", address, item)
+		}
+	}
+	for _, address := range []string{"10.0.0.10", "10.0.0.11", "10.0.0.12"} {
+		if item := items[address]; item == nil || item.Public || item.ResourceID != "i-web" {
+			t.Errorf("%s 应该是 i-web 的内网地址: %+v", address, item)
+		}
+	}
+	if item := items["10.0.1.10"]; item == nil || item.Public || item.ResourceID != "i-db" {
+		t.Errorf("没有公网 IP 的实例应该只有内网地址: %+v", item)
+	}
+}
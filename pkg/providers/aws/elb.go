@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type elbProvider struct {
+	id       string
+	provider string
+	session  *session.Session
+	regions  []string
+	list     *schema.Resources
+}
+
+func (d *elbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeLoadBalancers)
+	return d.list, nil
+}
+
+// describeLoadBalancers 获取 Classic Load Balancer 以及 ALB、NLB 等 v2 负载均衡
+func (d *elbProvider) describeLoadBalancers(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 AWS ELB 资源信息", region)
+		elbClient := elb.New(d.session, aws.NewConfig().WithRegion(region))
+		err = elbClient.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+			if len(page.LoadBalancerDescriptions) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 Classic ELB 资源", region, len(page.LoadBalancerDescriptions))
+			}
+			for _, loadBalancer := range page.LoadBalancerDescriptions {
+				scheme := aws.StringValue(loadBalancer.Scheme)
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "ELB",
+					Region:     region,
+					ResourceID: aws.StringValue(loadBalancer.LoadBalancerName),
+					DNSName:    aws.StringValue(loadBalancer.DNSName),
+					Public:     scheme == "internet-facing",
+					Metadata:   map[string]string{"type": "classic", "scheme": scheme},
+				})
+			}
+			return true
+		})
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下的 Classic ELB 资源: %s", region, err)
+		}
+
+		elbv2Client := elbv2.New(d.session, aws.NewConfig().WithRegion(region))
+		err = elbv2Client.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+			if len(page.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 ELB 资源", region, len(page.LoadBalancers))
+			}
+			for _, loadBalancer := range page.LoadBalancers {
+				scheme := aws.StringValue(loadBalancer.Scheme)
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "ELB",
+					Region:     region,
+					ResourceID: aws.StringValue(loadBalancer.LoadBalancerName),
+					DNSName:    aws.StringValue(loadBalancer.DNSName),
+					Public:     scheme == "internet-facing",
+					Metadata:   map[string]string{"type": aws.StringValue(loadBalancer.Type), "scheme": scheme},
+				})
+			}
+			return true
+		})
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下的 ELB 资源: %s", region, err)
+		}
+	}
+	return err
+}
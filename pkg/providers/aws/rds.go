@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strconv"
+	"sync"
+)
+
+type rdsProvider struct {
+	id       string
+	provider string
+	session  *session.Session
+	regions  []string
+	list     *schema.Resources
+}
+
+func (d *rdsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeDBInstances)
+	return d.list, nil
+}
+
+func (d *rdsProvider) describeDBInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 AWS RDS 资源信息", region)
+		rdsClient := rds.New(d.session, aws.NewConfig().WithRegion(region))
+		err = rdsClient.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+			if len(page.DBInstances) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 RDS 资源", region, len(page.DBInstances))
+			}
+			for _, instance := range page.DBInstances {
+				if instance.Endpoint == nil {
+					continue
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "RDS",
+					Region:     region,
+					ResourceID: aws.StringValue(instance.DBInstanceIdentifier),
+					DNSName:    aws.StringValue(instance.Endpoint.Address),
+					Public:     aws.BoolValue(instance.PubliclyAccessible),
+					Metadata: map[string]string{
+						"engine": aws.StringValue(instance.Engine) + " " + aws.StringValue(instance.EngineVersion),
+						"port":   strconv.FormatInt(aws.Int64Value(instance.Endpoint.Port), 10),
+					},
+				})
+			}
+			return true
+		})
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下的 RDS 资源: %s", region, err)
+		}
+	}
+	return err
+}
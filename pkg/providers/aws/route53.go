@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+type route53Provider struct {
+	id       string
+	provider string
+	session  *session.Session
+}
+
+func (d *route53Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 AWS Route 53 资源信息")
+	route53Client := route53.New(d.session)
+	err := route53Client.ListHostedZonesPages(&route53.ListHostedZonesInput{}, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		for _, zone := range page.HostedZones {
+			// 私有托管区域只能在 VPC 内解析
+			if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			domainName := strings.TrimSuffix(aws.StringValue(zone.Name), ".")
+			records := d.listResourceRecordSets(route53Client, zone.Id, domainName)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", domainName, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "Route53", ResourceID: strings.TrimPrefix(aws.StringValue(zone.Id), "/hostedzone/")}, domainName)
+		}
+		return true
+	})
+	if err != nil {
+		gologger.Debug().Msgf("无法获取 Route 53 托管区域列表: %s", err)
+	}
+	return list, nil
+}
+
+// listResourceRecordSets 返回托管区域下所有 A、AAAA 和 CNAME 解析记录，按照完整的域名聚合
+func (d *route53Provider) listResourceRecordSets(route53Client *route53.Route53, zoneId *string, domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	err := route53Client.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{HostedZoneId: zoneId}, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, recordSet := range page.ResourceRecordSets {
+			recordType := aws.StringValue(recordSet.Type)
+			fqdn := strings.TrimSuffix(aws.StringValue(recordSet.Name), ".")
+			var values []string
+			for _, resourceRecord := range recordSet.ResourceRecords {
+				values = append(values, aws.StringValue(resourceRecord.Value))
+			}
+			// 别名记录指向 ELB、CloudFront 等 AWS 资源
+			if recordSet.AliasTarget != nil {
+				values = append(values, strings.TrimSuffix(aws.StringValue(recordSet.AliasTarget.DNSName), "."))
+			}
+			for _, value := range values {
+				records.Add(fqdn, recordType, value, "")
+			}
+		}
+		return true
+	})
+	if err != nil {
+		gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+	}
+	return records
+}
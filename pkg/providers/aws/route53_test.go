@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"testing"
+)
+
+func TestRoute53AggregatesRecords(t *testing.T) {
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2013-04-01/hostedzone":
+			fmt.Fprint(w, `<ListHostedZonesResponse><HostedZones>`+
+				`<HostedZone><Id>/hostedzone/Z1PUBLIC</Id><Name>example.com.</Name><CallerReference>a</CallerReference>`+
+				`<Config><PrivateZone>false</PrivateZone></Config></HostedZone>`+
+				`<HostedZone><Id>/hostedzone/Z2PRIVATE</Id><Name>internal.example.com.</Name><CallerReference>b</CallerReference>`+
+				`<Config><PrivateZone>true</PrivateZone></Config></HostedZone>`+
+				`</HostedZones><IsTruncated>false</IsTruncated><MaxItems>100</MaxItems></ListHostedZonesResponse>`)
+		case "/2013-04-01/hostedzone/Z1PUBLIC/rrset":
+			fmt.Fprint(w, `<ListResourceRecordSetsResponse><ResourceRecordSets>`+
+				`<ResourceRecordSet><Name>www.example.com.</Name><Type>A</Type><TTL>300</TTL><ResourceRecords>`+
+				`<ResourceRecord><Value>54.1.1.1</Value></ResourceRecord>`+
+				`<ResourceRecord><Value>54.1.1.2</Value></ResourceRecord>`+
+				`</ResourceRecords></ResourceRecordSet>`+
+				`<ResourceRecordSet><Name>www.example.com.</Name><Type>AAAA</Type><TTL>300</TTL><ResourceRecords>`+
+				`<ResourceRecord><Value>2600:1f14::10</Value></ResourceRecord>`+
+				`</ResourceRecords></ResourceRecordSet>`+
+				`<ResourceRecordSet><Name>lb.example.com.</Name><Type>A</Type>`+
+				`<AliasTarget><HostedZoneId>Z1H1FL5HABSF5</HostedZoneId><DNSName>my-lb-1.us-west-2.elb.amazonaws.com.</DNSName>`+
+				`<EvaluateTargetHealth>false</EvaluateTargetHealth></AliasTarget></ResourceRecordSet>`+
+				`<ResourceRecordSet><Name>example.com.</Name><Type>MX</Type><TTL>300</TTL><ResourceRecords>`+
+				`<ResourceRecord><Value>10 mx.example.com.</Value></ResourceRecord>`+
+				`</ResourceRecords></ResourceRecordSet>`+
+				`</ResourceRecordSets><IsTruncated>false</IsTruncated><MaxItems>100</MaxItems></ListResourceRecordSetsResponse>`)
+		default:
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+	route53Provider := &route53Provider{id: "test", provider: utils.Aws, session: provider.session}
+	list, err := route53Provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		for _, address := range []string{item.DNSName, item.PublicIPv4, item.PublicIPv6} {
+			if address != "" {
+				items[address] = item
+			}
+		}
+	}
+	if len(items) != 5 {
+		t.Fatalf("应该得到 2 个域名和 3 个 IP，实际为 %d 个: %v", len(items), items)
+	}
+	www := items["www.example.com"]
+	if www == nil || www.ResourceID != "Z1PUBLIC" || www.Metadata["record_type"] != "A, AAAA" ||
+		www.Metadata["record_value"] != "54.1.1.1, 54.1.1.2, 2600:1f14::10" || www.Metadata["domain"] != "example.com" {
+		t.Errorf("www.example.com 的资产不正确: %+v", www)
+	}
+	for _, address := range []string{"54.1.1.1", "54.1.1.2", "2600:1f14::10"} {
+		if items[address] == nil || items[address].Service != "Route53" {
+			t.Errorf("%s 应该作为 www.example.com 的公网地址: %v", address, items)
+		}
+	}
+	if lb := items["lb.example.com"]; lb == nil || lb.Metadata["record_value"] != "my-lb-1.us-west-2.elb.amazonaws.com" {
+		t.Errorf("别名记录的值不正确: %+v", lb)
+	}
+}
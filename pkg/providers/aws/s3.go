@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/s3"
+	"github.com/wgpsec/lc/pkg/schema"
+)
+
+type s3Provider struct {
+	id       string
+	provider string
+	session  *session.Session
+}
+
+func (d *s3Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	gologger.Debug().Msg("正在获取 AWS S3 资源信息")
+	lister := &s3.BucketLister{
+		ID:       d.id,
+		Provider: d.provider,
+		Service:  "S3",
+		Endpoint: aws.StringValue(d.session.Config.Endpoint),
+		// us-east-1 区域的 LocationConstraint 为空
+		Region:      "us-east-1",
+		PathStyle:   aws.BoolValue(d.session.Config.S3ForcePathStyle),
+		Credentials: d.session.Config.Credentials,
+		Host:        "{bucket}.s3.{region}.amazonaws.com",
+	}
+	return lister.GetResource(ctx)
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestProvider 通过 endpoint 把所有 AWS 请求发送到 handler
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	provider, err := New(schema.OptionBlock{
+		utils.AccessKey: "AKIDEXAMPLE",
+		utils.SecretKey: "secret",
+		utils.Region:    "us-west-2",
+		utils.Endpoint:  server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return provider
+}
+
+func TestS3ContinuesWhenLocationFails(t *testing.T) {
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			fmt.Fprint(w, `<ListAllMyBucketsResult><Buckets>`+
+				`<Bucket><Name>logs</Name></Bucket>`+
+				`<Bucket><Name>assets</Name></Bucket>`+
+				`</Buckets></ListAllMyBucketsResult>`)
+		case r.URL.Path == "/logs" && r.URL.Query().Has("location"):
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+		case r.URL.Path == "/assets" && r.URL.Query().Has("location"):
+			fmt.Fprint(w, `<LocationConstraint>eu-west-1</LocationConstraint>`)
+		default:
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+	s3Provider := &s3Provider{id: "test", provider: utils.Aws, session: provider.session}
+	list, err := s3Provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]string)
+	for _, item := range list.GetItems() {
+		names[item.DNSName] = item.Region
+	}
+	if names["logs.s3.us-east-1.amazonaws.com"] != "us-east-1" || names["assets.s3.eu-west-1.amazonaws.com"] != "eu-west-1" {
+		t.Errorf("获取区域失败的存储桶也应该保留: %v", names)
+	}
+}
+
+func TestS3ListBucketsFailure(t *testing.T) {
+	provider := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+	})
+	s3Provider := &s3Provider{id: "test", provider: utils.Aws, session: provider.session}
+	list, err := s3Provider.GetResource(context.Background())
+	if err != nil {
+		t.Fatalf("没有 S3 权限时不应该返回错误: %s", err)
+	}
+	if len(list.GetItems()) != 0 {
+		t.Errorf("不应该获取到存储桶: %v", list.GetItems())
+	}
+}
@@ -0,0 +1,132 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type listSubscriptionsResponse struct {
+	Value []struct {
+		SubscriptionID string `json:"subscriptionId"`
+		State          string `json:"state"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	clientID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	clientSecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	tenantID, ok := options.GetMetadata(utils.TenantId)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.TenantId}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+
+	gologger.Debug().Msg("找到 Azure 服务主体凭证")
+
+	// 配置 endpoint 和 auth_endpoint 后可以请求 Azure 中国等其他云环境，或者本地模拟服务
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "https://management.azure.com"
+	}
+	authEndpoint, ok := options.GetMetadata(utils.AuthEndpoint)
+	if !ok {
+		authEndpoint = "https://login.microsoftonline.com"
+	}
+	armClient := newARMClient(endpoint, authEndpoint, tenantID, clientID, clientSecret)
+
+	// subscriptions
+	var subscriptions []string
+	if subscriptionID, ok := options.GetMetadata(utils.SubscriptionId); ok {
+		subscriptions = append(subscriptions, subscriptionID)
+	} else {
+		path := "/subscriptions"
+		for path != "" {
+			var response listSubscriptionsResponse
+			if err := armClient.get(path, "2022-12-01", &response); err != nil {
+				return nil, err
+			}
+			for _, subscription := range response.Value {
+				if subscription.State == "Enabled" {
+					subscriptions = append(subscriptions, subscription.SubscriptionID)
+				}
+			}
+			path = response.NextLink
+		}
+	}
+
+	return &Provider{id: id, provider: utils.Azure, armClient: armClient, subscriptions: subscriptions}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	vmProvider := &vmProvider{id: p.id, provider: p.provider, armClient: p.armClient, subscriptions: p.subscriptions}
+	vmList, err := vmProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Azure VM 信息", len(vmList.GetItems()))
+
+	publicIPProvider := &publicIPProvider{id: p.id, provider: p.provider, armClient: p.armClient, subscriptions: p.subscriptions}
+	publicIPList, err := publicIPProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Azure 公共 IP 信息", len(publicIPList.GetItems()))
+
+	lbProvider := &lbProvider{id: p.id, provider: p.provider, armClient: p.armClient, subscriptions: p.subscriptions}
+	lbList, err := lbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Azure 负载均衡信息", len(lbList.GetItems()))
+
+	storageProvider := &storageProvider{id: p.id, provider: p.provider, armClient: p.armClient, subscriptions: p.subscriptions}
+	storageList, err := storageProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Azure 存储账户信息", len(storageList.GetItems()))
+
+	dnsProvider := &dnsProvider{id: p.id, provider: p.provider, armClient: p.armClient, subscriptions: p.subscriptions}
+	dnsList, err := dnsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Azure DNS 解析信息", len(dnsList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(vmList)
+	finalList.Merge(publicIPList)
+	finalList.Merge(lbList)
+	finalList.Merge(storageList)
+	finalList.Merge(dnsList)
+	finalList.AddSummary("VM", len(vmList.GetItems()))
+	finalList.AddSummary("PublicIP", len(publicIPList.GetItems()))
+	finalList.AddSummary("LB", len(lbList.GetItems()))
+	finalList.AddSummary("Storage", len(storageList.GetItems()))
+	finalList.AddSummary("DNS", len(dnsList.GetItems()))
+	return finalList, nil
+}
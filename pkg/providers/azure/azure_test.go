@@ -0,0 +1,149 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestServer 模拟 Azure AD 和 ARM，pages 的 key 为请求路径（包含查询参数），value 为返回的 JSON
+func newTestServer(t *testing.T, tokenRequests *int32, pages func(serverURL string) map[string]interface{}) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/tenant-1/oauth2/v2.0/token" {
+			atomic.AddInt32(tokenRequests, 1)
+			if r.Method != http.MethodPost || r.FormValue("grant_type") != "client_credentials" ||
+				r.FormValue("client_id") != "client-1" || r.FormValue("client_secret") != "secret-1" ||
+				r.FormValue("scope") != server.URL+"/.default" {
+				t.Errorf("获取令牌的请求不正确: %s %v", r.Method, r.Form)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token-1", "expires_in": 3600})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			t.Errorf("请求没有携带访问令牌: %s", r.URL)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		page, ok := pages(server.URL)[r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]string{"code": "NotFound", "message": r.URL.RequestURI()}})
+			return
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestProvider(t *testing.T, server *httptest.Server, options schema.OptionBlock) *Provider {
+	block := schema.OptionBlock{
+		utils.AccessKey:    "client-1",
+		utils.SecretKey:    "secret-1",
+		utils.TenantId:     "tenant-1",
+		utils.Endpoint:     server.URL,
+		utils.AuthEndpoint: server.URL,
+	}
+	for key, value := range options {
+		block[key] = value
+	}
+	provider, err := New(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return provider
+}
+
+func TestNewListsSubscriptionsWithNextLink(t *testing.T) {
+	var tokenRequests int32
+	server := newTestServer(t, &tokenRequests, func(serverURL string) map[string]interface{} {
+		return map[string]interface{}{
+			"/subscriptions?api-version=2022-12-01": map[string]interface{}{
+				"value":    []map[string]string{{"subscriptionId": "sub-1", "state": "Enabled"}},
+				"nextLink": serverURL + "/subscriptions?api-version=2022-12-01&%24skiptoken=page2",
+			},
+			"/subscriptions?api-version=2022-12-01&%24skiptoken=page2": map[string]interface{}{
+				"value": []map[string]string{
+					{"subscriptionId": "sub-2", "state": "Enabled"},
+					{"subscriptionId": "sub-3", "state": "Disabled"},
+				},
+			},
+		}
+	})
+	provider := newTestProvider(t, server, nil)
+	if strings.Join(provider.subscriptions, ",") != "sub-1,sub-2" {
+		t.Errorf("订阅列表不正确: %v", provider.subscriptions)
+	}
+	// 访问令牌在有效期内会被缓存
+	if tokenRequests != 1 {
+		t.Errorf("应该只获取一次访问令牌，实际为 %d 次", tokenRequests)
+	}
+}
+
+func TestVMResources(t *testing.T) {
+	var tokenRequests int32
+	const (
+		vmID       = "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/web"
+		publicIPID = "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/web-ip"
+	)
+	server := newTestServer(t, &tokenRequests, func(serverURL string) map[string]interface{} {
+		nicPath := "/subscriptions/sub-1/providers/Microsoft.Network/networkInterfaces?api-version=2023-05-01"
+		return map[string]interface{}{
+			"/subscriptions/sub-1/providers/Microsoft.Network/publicIPAddresses?api-version=2023-05-01": map[string]interface{}{
+				"value": []map[string]interface{}{
+					// 资源 ID 不区分大小写
+					{"id": strings.ToUpper(publicIPID), "location": "eastus", "properties": map[string]string{"ipAddress": "20.0.0.1"}},
+				},
+			},
+			nicPath: map[string]interface{}{
+				"value": []map[string]interface{}{
+					// 没有挂载到虚拟机的网卡会被忽略
+					{"id": "nic-0", "location": "eastus", "properties": map[string]interface{}{
+						"ipConfigurations": []map[string]interface{}{{"properties": map[string]string{"privateIPAddress": "10.0.0.9"}}},
+					}},
+				},
+				"nextLink": serverURL + nicPath + "&%24skiptoken=page2",
+			},
+			nicPath + "&%24skiptoken=page2": map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "nic-1", "location": "eastus", "properties": map[string]interface{}{
+						"virtualMachine": map[string]string{"id": vmID},
+						"ipConfigurations": []map[string]interface{}{{"properties": map[string]interface{}{
+							"privateIPAddress": "10.0.0.4",
+							"publicIPAddress":  map[string]string{"id": publicIPID},
+						}}},
+					}},
+				},
+			},
+		}
+	})
+	provider := newTestProvider(t, server, schema.OptionBlock{utils.SubscriptionId: "sub-1"})
+	vmProvider := &vmProvider{id: "test", provider: utils.Azure, armClient: provider.armClient, subscriptions: provider.subscriptions}
+	list, err := vmProvider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addresses := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		addresses[item.PublicIPv4+item.PrivateIpv4] = item
+	}
+	if len(addresses) != 2 || addresses["10.0.0.9"] != nil {
+		t.Fatalf("VM 的地址不正确: %v", addresses)
+	}
+	public := addresses["20.0.0.1"]
+	if public == nil || !public.Public || public.ResourceID != vmID || public.Region != "eastus" || public.Metadata["name"] != "web" {
+		t.Errorf("VM 的公网地址不正确: %+v", public)
+	}
+	if private := addresses["10.0.0.4"]; private == nil || private.Public {
+		t.Errorf("VM 的内网地址不正确: %+v", private)
+	}
+}
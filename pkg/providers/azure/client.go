@@ -0,0 +1,124 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// armClient 使用服务主体的客户端凭据调用 Azure Resource Manager API
+type armClient struct {
+	endpoint     string
+	authEndpoint string
+	tenantID     string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type armError struct {
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newARMClient(endpoint, authEndpoint, tenantID, clientID, clientSecret string) *armClient {
+	return &armClient{
+		endpoint:     strings.TrimSuffix(endpoint, "/"),
+		authEndpoint: strings.TrimSuffix(authEndpoint, "/"),
+		tenantID:     tenantID,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// token 返回缓存的访问令牌，过期前一分钟会重新获取
+func (c *armClient) token() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.accessToken != "" && time.Now().Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+		"scope":         {c.endpoint + "/.default"},
+	}
+	response, err := c.httpClient.PostForm(c.authEndpoint+"/"+c.tenantID+"/oauth2/v2.0/token", form)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("获取 Azure 访问令牌失败 %s: %s", response.Status, bytes.TrimSpace(body))
+	}
+	var result tokenResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+	c.accessToken = result.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+	return c.accessToken, nil
+}
+
+// get 请求 path 并将返回的 JSON 解析到 result 中，path 也可以是 nextLink 返回的完整地址
+func (c *armClient) get(path, apiVersion string, result interface{}) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	requestURL := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		requestURL = c.endpoint + path + "?api-version=" + apiVersion
+	}
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		var apiError armError
+		if json.Unmarshal(body, &apiError) == nil && apiError.Error != nil {
+			return fmt.Errorf("%s: %s %s", path, apiError.Error.Code, apiError.Error.Message)
+		}
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	return json.Unmarshal(body, result)
+}
+
+// resourceName 返回资源 ID 的最后一段，即资源名称
+func resourceName(id string) string {
+	return id[strings.LastIndex(id, "/")+1:]
+}
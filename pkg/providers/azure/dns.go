@@ -0,0 +1,95 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+type dnsProvider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type listDNSZonesResponse struct {
+	Value []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+type listRecordSetsResponse struct {
+	Value []struct {
+		Type       string `json:"type"`
+		Properties struct {
+			FQDN     string `json:"fqdn"`
+			ARecords []struct {
+				IPv4Address string `json:"ipv4Address"`
+			} `json:"ARecords"`
+			AAAARecords []struct {
+				IPv6Address string `json:"ipv6Address"`
+			} `json:"AAAARecords"`
+			CNAMERecord *struct {
+				CNAME string `json:"cname"`
+			} `json:"CNAMERecord"`
+		} `json:"properties"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func (d *dnsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, subscription := range d.subscriptions {
+		gologger.Debug().Msgf("正在获取 %s 订阅下的 Azure DNS 资源信息", subscription)
+		path := "/subscriptions/" + subscription + "/providers/Microsoft.Network/dnszones"
+		for path != "" {
+			var response listDNSZonesResponse
+			if err := d.armClient.get(path, "2018-05-01", &response); err != nil {
+				gologger.Debug().Msgf("无法获取 %s 订阅下的 DNS 区域列表: %s", subscription, err)
+				break
+			}
+			for _, zone := range response.Value {
+				records := d.listRecordSets(zone.ID, zone.Name)
+				if records.Len() > 0 {
+					gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", zone.Name, records.Len())
+				}
+				records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "DNS", ResourceID: zone.ID}, zone.Name)
+			}
+			path = response.NextLink
+		}
+	}
+	return list, nil
+}
+
+// listRecordSets 返回 DNS 区域下所有 A、AAAA 和 CNAME 解析记录，按照完整的域名聚合
+func (d *dnsProvider) listRecordSets(zoneId, domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	path := zoneId + "/recordsets"
+	for path != "" {
+		var response listRecordSetsResponse
+		if err := d.armClient.get(path, "2018-05-01", &response); err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+			break
+		}
+		for _, recordSet := range response.Value {
+			// type 的格式为 Microsoft.Network/dnszones/A
+			recordType := resourceName(recordSet.Type)
+			fqdn := strings.TrimSuffix(recordSet.Properties.FQDN, ".")
+			for _, item := range recordSet.Properties.ARecords {
+				records.Add(fqdn, recordType, item.IPv4Address, "")
+			}
+			for _, item := range recordSet.Properties.AAAARecords {
+				records.Add(fqdn, recordType, item.IPv6Address, "")
+			}
+			if recordSet.Properties.CNAMERecord != nil {
+				records.Add(fqdn, recordType, recordSet.Properties.CNAMERecord.CNAME, "")
+			}
+		}
+		path = response.NextLink
+	}
+	return records
+}
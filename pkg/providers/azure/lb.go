@@ -0,0 +1,75 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+type lbProvider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type listLoadBalancersResponse struct {
+	Value []struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		Location   string `json:"location"`
+		Properties struct {
+			FrontendIPConfigurations []struct {
+				Properties struct {
+					PrivateIPAddress string `json:"privateIPAddress"`
+					PublicIPAddress  *struct {
+						ID string `json:"id"`
+					} `json:"publicIPAddress"`
+				} `json:"properties"`
+			} `json:"frontendIPConfigurations"`
+		} `json:"properties"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func (d *lbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, subscription := range d.subscriptions {
+		gologger.Debug().Msgf("正在获取 %s 订阅下的 Azure 负载均衡资源信息", subscription)
+		publicIPs := publicIPAddressesByID(d.armClient, subscription)
+		path := "/subscriptions/" + subscription + "/providers/Microsoft.Network/loadBalancers"
+		for path != "" {
+			var response listLoadBalancersResponse
+			if err := d.armClient.get(path, "2023-05-01", &response); err != nil {
+				gologger.Debug().Msgf("无法获取 %s 订阅下的负载均衡资源: %s", subscription, err)
+				break
+			}
+			for _, loadBalancer := range response.Value {
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+				)
+				for _, frontend := range loadBalancer.Properties.FrontendIPConfigurations {
+					privateIPv4s = append(privateIPv4s, frontend.Properties.PrivateIPAddress)
+					if frontend.Properties.PublicIPAddress != nil {
+						publicIPv4s = append(publicIPv4s, publicIPs[strings.ToLower(frontend.Properties.PublicIPAddress.ID)])
+					}
+				}
+				list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "LB",
+					Region:       loadBalancer.Location,
+					ResourceID:   loadBalancer.ID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					Public:       len(publicIPv4s) > 0,
+					Metadata:     map[string]string{"name": loadBalancer.Name},
+				})
+			}
+			path = response.NextLink
+		}
+	}
+	return list, nil
+}
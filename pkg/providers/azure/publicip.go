@@ -0,0 +1,95 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+type publicIPProvider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type publicIPAddress struct {
+	ID         string `json:"id"`
+	Location   string `json:"location"`
+	Properties struct {
+		IPAddress   string `json:"ipAddress"`
+		DNSSettings *struct {
+			FQDN string `json:"fqdn"`
+		} `json:"dnsSettings"`
+		IPConfiguration *struct {
+			ID string `json:"id"`
+		} `json:"ipConfiguration"`
+	} `json:"properties"`
+}
+
+type listPublicIPAddressesResponse struct {
+	Value    []publicIPAddress `json:"value"`
+	NextLink string            `json:"nextLink"`
+}
+
+func (d *publicIPProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, subscription := range d.subscriptions {
+		gologger.Debug().Msgf("正在获取 %s 订阅下的 Azure 公共 IP 资源信息", subscription)
+		publicIPs, err := listPublicIPAddresses(d.armClient, subscription)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 订阅下的公共 IP 资源: %s", subscription, err)
+			continue
+		}
+		for _, publicIP := range publicIPs {
+			metadata := map[string]string{}
+			if publicIP.Properties.IPConfiguration != nil {
+				metadata["ip_configuration"] = publicIP.Properties.IPConfiguration.ID
+			}
+			resource := &schema.Resource{
+				ID:         d.id,
+				Provider:   d.provider,
+				Service:    "PublicIP",
+				Region:     publicIP.Location,
+				ResourceID: publicIP.ID,
+				PublicIPv4: publicIP.Properties.IPAddress,
+				Public:     true,
+				Metadata:   metadata,
+			}
+			if publicIP.Properties.DNSSettings != nil {
+				resource.DNSName = publicIP.Properties.DNSSettings.FQDN
+			}
+			list.Append(resource)
+		}
+	}
+	return list, nil
+}
+
+// listPublicIPAddresses 返回订阅下所有的公共 IP
+func listPublicIPAddresses(client *armClient, subscription string) ([]publicIPAddress, error) {
+	var publicIPs []publicIPAddress
+	path := "/subscriptions/" + subscription + "/providers/Microsoft.Network/publicIPAddresses"
+	for path != "" {
+		var response listPublicIPAddressesResponse
+		if err := client.get(path, "2023-05-01", &response); err != nil {
+			return publicIPs, err
+		}
+		publicIPs = append(publicIPs, response.Value...)
+		path = response.NextLink
+	}
+	return publicIPs, nil
+}
+
+// publicIPAddressesByID 返回以小写资源 ID 为 key 的公共 IP 地址，Azure 的资源 ID 不区分大小写
+func publicIPAddressesByID(client *armClient, subscription string) map[string]string {
+	addresses := make(map[string]string)
+	publicIPs, err := listPublicIPAddresses(client, subscription)
+	if err != nil {
+		gologger.Debug().Msgf("无法获取 %s 订阅下的公共 IP 资源: %s", subscription, err)
+	}
+	for _, publicIP := range publicIPs {
+		addresses[strings.ToLower(publicIP.ID)] = publicIP.Properties.IPAddress
+	}
+	return addresses
+}
@@ -0,0 +1,67 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type storageProvider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type listStorageAccountsResponse struct {
+	Value []struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		Location   string `json:"location"`
+		Properties struct {
+			PrimaryEndpoints map[string]interface{} `json:"primaryEndpoints"`
+		} `json:"properties"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func (d *storageProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, subscription := range d.subscriptions {
+		gologger.Debug().Msgf("正在获取 %s 订阅下的 Azure 存储账户资源信息", subscription)
+		path := "/subscriptions/" + subscription + "/providers/Microsoft.Storage/storageAccounts"
+		for path != "" {
+			var response listStorageAccountsResponse
+			if err := d.armClient.get(path, "2023-01-01", &response); err != nil {
+				gologger.Debug().Msgf("无法获取 %s 订阅下的存储账户资源: %s", subscription, err)
+				break
+			}
+			for _, account := range response.Value {
+				// primaryEndpoints 包含 blob、file、queue、table、web、dfs 等服务的地址
+				for service, endpoint := range account.Properties.PrimaryEndpoints {
+					endpointURL, ok := endpoint.(string)
+					if !ok {
+						continue
+					}
+					parsed, err := url.Parse(endpointURL)
+					if err != nil || parsed.Hostname() == "" {
+						continue
+					}
+					list.Append(&schema.Resource{
+						ID:         d.id,
+						Provider:   d.provider,
+						Service:    "Storage",
+						Region:     account.Location,
+						ResourceID: account.Name,
+						DNSName:    parsed.Hostname(),
+						Public:     true,
+						Metadata:   map[string]string{"endpoint_type": service},
+					})
+				}
+			}
+			path = response.NextLink
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,81 @@
+package azure
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+type vmProvider struct {
+	id            string
+	provider      string
+	armClient     *armClient
+	subscriptions []string
+}
+
+type listNetworkInterfacesResponse struct {
+	Value []struct {
+		ID         string `json:"id"`
+		Location   string `json:"location"`
+		Properties struct {
+			VirtualMachine *struct {
+				ID string `json:"id"`
+			} `json:"virtualMachine"`
+			IPConfigurations []struct {
+				Properties struct {
+					PrivateIPAddress string `json:"privateIPAddress"`
+					PublicIPAddress  *struct {
+						ID string `json:"id"`
+					} `json:"publicIPAddress"`
+				} `json:"properties"`
+			} `json:"ipConfigurations"`
+		} `json:"properties"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func (d *vmProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, subscription := range d.subscriptions {
+		gologger.Debug().Msgf("正在获取 %s 订阅下的 Azure VM 资源信息", subscription)
+		publicIPs := publicIPAddressesByID(d.armClient, subscription)
+		// 虚拟机的 IP 地址记录在网卡上，因此通过网卡获取虚拟机的地址
+		path := "/subscriptions/" + subscription + "/providers/Microsoft.Network/networkInterfaces"
+		for path != "" {
+			var response listNetworkInterfacesResponse
+			if err := d.armClient.get(path, "2023-05-01", &response); err != nil {
+				gologger.Debug().Msgf("无法获取 %s 订阅下的网卡资源: %s", subscription, err)
+				break
+			}
+			for _, nic := range response.Value {
+				if nic.Properties.VirtualMachine == nil {
+					continue
+				}
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+				)
+				for _, ipConfiguration := range nic.Properties.IPConfigurations {
+					privateIPv4s = append(privateIPv4s, ipConfiguration.Properties.PrivateIPAddress)
+					if ipConfiguration.Properties.PublicIPAddress != nil {
+						publicIPv4s = append(publicIPv4s, publicIPs[strings.ToLower(ipConfiguration.Properties.PublicIPAddress.ID)])
+					}
+				}
+				list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "VM",
+					Region:       nic.Location,
+					ResourceID:   nic.Properties.VirtualMachine.ID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					Public:       len(publicIPv4s) > 0,
+					Metadata:     map[string]string{"name": resourceName(nic.Properties.VirtualMachine.ID)},
+				})
+			}
+			path = response.NextLink
+		}
+	}
+	return list, nil
+}
@@ -3,6 +3,7 @@ package baidu
 import (
 	"context"
 	"github.com/baidubce/bce-sdk-go/auth"
+	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bos"
 	"github.com/projectdiscovery/gologger"
 	"github.com/wgpsec/lc/pkg/schema"
@@ -23,6 +24,19 @@ type providerConfig struct {
 	okST            bool
 }
 
+// setCredentials 在使用临时访问凭证时替换客户端的凭证，客户端需要使用 AK/SK 和区域 endpoint 创建
+func (c providerConfig) setCredentials(client *bce.BceClient) error {
+	if !c.okST {
+		return nil
+	}
+	stsCredential, err := auth.NewSessionBceCredentials(c.accessKeyID, c.accessKeySecret, c.sessionToken)
+	if err != nil {
+		return err
+	}
+	client.Config.Credentials = stsCredential
+	return nil
+}
+
 func New(options schema.OptionBlock) (*Provider, error) {
 	var (
 		endpoint  = "https://bj.bcebos.com"
@@ -97,9 +111,35 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条百度云 BOS 信息", len(buckets.GetItems()))
+	blbProvider := &blbProvider{provider: p.provider, id: p.id, config: p.config}
+	blbList, err := blbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条百度云 BLB 信息", len(blbList.GetItems()))
+	eipProvider := &eipProvider{provider: p.provider, id: p.id, config: p.config}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条百度云 EIP 信息", len(eipList.GetItems()))
+	rdsProvider := &rdsProvider{provider: p.provider, id: p.id, config: p.config}
+	rdsList, err := rdsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条百度云 RDS 信息", len(rdsList.GetItems()))
 	finalList := schema.NewResources()
 	finalList.Merge(lists)
 	finalList.Merge(buckets)
+	finalList.Merge(blbList)
+	finalList.Merge(eipList)
+	finalList.Merge(rdsList)
+	finalList.AddSummary("BCC", len(lists.GetItems()))
+	finalList.AddSummary("BOS", len(buckets.GetItems()))
+	finalList.AddSummary("BLB", len(blbList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("RDS", len(rdsList.GetItems()))
 	return finalList, nil
 }
 
@@ -2,7 +2,6 @@ package baidu
 
 import (
 	"context"
-	"github.com/baidubce/bce-sdk-go/auth"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/wgpsec/lc/pkg/schema"
@@ -17,40 +16,11 @@ type instanceProvider struct {
 
 var list = schema.NewResources()
 
-func (d *instanceProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		err     error
-		wg      sync.WaitGroup
-	)
-	var endpoints = []string{
-		"https://bcc.bj.baidubce.com",
-		"https://bcc.gz.baidubce.com",
-		"https://bcc.su.baidubce.com",
-		"https://bcc.hkg.baidubce.com",
-		"https://bcc.fwh.baidubce.com",
-		"https://bcc.bd.baidubce.com",
-		"https://bcc.cd.baidubce.com",
-		"https://bcc.nj.baidubce.com",
-		"https://bcc.fsh.baidubce.com",
-	}
-	threads = schema.GetThreads()
+// regions 是百度云各个区域在 endpoint 中使用的名称
+var regions = []string{"bj", "gz", "su", "hkg", "fwh", "bd", "cd", "nj", "fsh"}
 
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.describeInstances(taskCh, &wg)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range endpoints {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
+func (d *instanceProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	schema.RunWorkers(regions, d.describeInstances)
 	return list, nil
 }
 
@@ -60,25 +30,14 @@ func (d *instanceProvider) describeInstances(ch <-chan string, wg *sync.WaitGrou
 		err       error
 		bccClient *bcc.Client
 	)
-	for endpoint := range ch {
-		if d.config.okST {
-			bccClient, err = bcc.NewClient(d.config.accessKeyID, d.config.accessKeySecret, "")
-			if err != nil {
-				continue
-			}
-			stsCredential, err := auth.NewSessionBceCredentials(
-				d.config.accessKeyID,
-				d.config.accessKeySecret,
-				d.config.sessionToken)
-			if err != nil {
-				continue
-			}
-			bccClient.Config.Credentials = stsCredential
-		} else {
-			bccClient, err = bcc.NewClient(d.config.accessKeyID, d.config.accessKeySecret, endpoint)
-			if err != nil {
-				continue
-			}
+	for region := range ch {
+		endpoint := "https://bcc." + region + ".baidubce.com"
+		bccClient, err = bcc.NewClient(d.config.accessKeyID, d.config.accessKeySecret, endpoint)
+		if err != nil {
+			continue
+		}
+		if err = d.config.setCredentials(bccClient.BceClient); err != nil {
+			continue
 		}
 		listArgs := &api.ListInstanceArgs{}
 		for {
@@ -88,17 +47,26 @@ func (d *instanceProvider) describeInstances(ch <-chan string, wg *sync.WaitGrou
 			}
 			for _, instance := range response.Instances {
 				var (
-					ipv4        string
-					privateIPv4 string
+					privateIPv4 = []string{instance.InternalIP}
+					eips        []string
 				)
-				ipv4 = instance.PublicIP
-				privateIPv4 = instance.InternalIP
+				for _, ip := range instance.NicInfo.Ips {
+					privateIPv4 = append(privateIPv4, ip.PrivateIp)
+					if ip.Eip != "" {
+						eips = append(eips, ip.Eip)
+					}
+				}
 				list.Append(&schema.Resource{
-					ID:          d.id,
-					Provider:    d.provider,
-					PublicIPv4:  ipv4,
-					PrivateIpv4: privateIPv4,
-					Public:      ipv4 != "",
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "BCC",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					PublicIPv4:   instance.PublicIP,
+					EIPs:         eips,
+					PrivateIpv4s: privateIPv4,
+					IPv6s:        []string{instance.Ipv6},
+					Public:       instance.PublicIP != "" || len(eips) > 0 || instance.Ipv6 != "",
 				})
 			}
 			if response.NextMarker == "" {
@@ -0,0 +1,68 @@
+package baidu
+
+import (
+	"context"
+	"github.com/baidubce/bce-sdk-go/services/blb"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type blbProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+	list     *schema.Resources
+}
+
+func (d *blbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.describeLoadBalancers)
+	return d.list, nil
+}
+
+func (d *blbProvider) describeLoadBalancers(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		blbClient *blb.Client
+	)
+	for region := range ch {
+		blbClient, err = blb.NewClient(d.config.accessKeyID, d.config.accessKeySecret, "https://blb."+region+".baidubce.com")
+		if err != nil {
+			continue
+		}
+		if err = d.config.setCredentials(blbClient.BceClient); err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的百度云 BLB 资源信息", region)
+		args := &blb.DescribeLoadBalancersArgs{MaxKeys: 1000}
+		for {
+			response, err := blbClient.DescribeLoadBalancers(args)
+			if err != nil {
+				break
+			}
+			if len(response.BlbList) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 BLB 资源", region, len(response.BlbList))
+			}
+			for _, loadBalancer := range response.BlbList {
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "BLB",
+					Region:      region,
+					ResourceID:  loadBalancer.BlbId,
+					PublicIPv4:  loadBalancer.PublicIp,
+					PrivateIpv4: loadBalancer.Address,
+					Public:      loadBalancer.PublicIp != "",
+					Metadata:    map[string]string{"name": loadBalancer.Name},
+				})
+			}
+			if !response.IsTruncated || response.NextMarker == "" {
+				break
+			}
+			args.Marker = response.NextMarker
+		}
+	}
+	return err
+}
@@ -27,10 +27,13 @@ func (d *bosProvider) GetResource(ctx context.Context) (*schema.Resources, error
 		endpointBuilder.WriteString("." + bucket.Location)
 		endpointBuilder.WriteString(".bcebos.com")
 		list.Append(&schema.Resource{
-			ID:       d.id,
-			Public:   true,
-			DNSName:  endpointBuilder.String(),
-			Provider: d.provider,
+			ID:         d.id,
+			Public:     true,
+			DNSName:    endpointBuilder.String(),
+			Provider:   d.provider,
+			Service:    "BOS",
+			Region:     bucket.Location,
+			ResourceID: bucket.Name,
 		})
 	}
 	return list, nil
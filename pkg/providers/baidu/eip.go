@@ -0,0 +1,72 @@
+package baidu
+
+import (
+	"context"
+	"github.com/baidubce/bce-sdk-go/services/eip"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type eipProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+	list     *schema.Resources
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.listEips)
+	return d.list, nil
+}
+
+func (d *eipProvider) listEips(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		eipClient *eip.Client
+	)
+	for region := range ch {
+		eipClient, err = eip.NewClient(d.config.accessKeyID, d.config.accessKeySecret, "https://eip."+region+".baidubce.com")
+		if err != nil {
+			continue
+		}
+		if err = d.config.setCredentials(eipClient.BceClient); err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的百度云 EIP 资源信息", region)
+		args := &eip.ListEipArgs{MaxKeys: 1000}
+		for {
+			response, err := eipClient.ListEip(args)
+			if err != nil {
+				break
+			}
+			if len(response.EipList) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(response.EipList))
+			}
+			for _, item := range response.EipList {
+				metadata := map[string]string{"status": item.Status}
+				if item.InstanceId != "" {
+					metadata["instance_id"] = item.InstanceId
+					metadata["instance_type"] = item.InstanceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     region,
+					ResourceID: item.EipId,
+					PublicIPv4: item.Eip,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if !response.IsTruncated || response.NextMarker == "" {
+				break
+			}
+			args.Marker = response.NextMarker
+		}
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package baidu
+
+import (
+	"context"
+	"github.com/baidubce/bce-sdk-go/services/rds"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strconv"
+	"sync"
+)
+
+type rdsProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+	list     *schema.Resources
+}
+
+func (d *rdsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.listRds)
+	return d.list, nil
+}
+
+func (d *rdsProvider) listRds(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var (
+		err       error
+		rdsClient *rds.Client
+	)
+	for region := range ch {
+		rdsClient, err = rds.NewClient(d.config.accessKeyID, d.config.accessKeySecret, "https://rds."+region+".baidubce.com")
+		if err != nil {
+			continue
+		}
+		if err = d.config.setCredentials(rdsClient.BceClient); err != nil {
+			continue
+		}
+		gologger.Debug().Msgf("正在获取 %s 区域下的百度云 RDS 资源信息", region)
+		args := &rds.ListRdsArgs{MaxKeys: 1000}
+		for {
+			response, err := rdsClient.ListRds(args)
+			if err != nil {
+				break
+			}
+			if len(response.Instances) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 RDS 资源", region, len(response.Instances))
+			}
+			for _, instance := range response.Instances {
+				// endpoint.Address 为连接域名，InetIp 为开通公网访问后的公网 IP
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "RDS",
+					Region:      region,
+					ResourceID:  instance.InstanceId,
+					DNSName:     instance.Endpoint.Address,
+					PublicIPv4:  instance.Endpoint.InetIp,
+					PrivateIpv4: instance.Endpoint.VnetIp,
+					Public:      instance.Endpoint.InetIp != "",
+					Metadata: map[string]string{
+						"engine": instance.Engine + " " + instance.EngineVersion,
+						"port":   strconv.Itoa(instance.Endpoint.Port),
+					},
+				})
+			}
+			if !response.IsTruncated || response.NextMarker == "" {
+				break
+			}
+			args.Marker = response.NextMarker
+		}
+	}
+	return err
+}
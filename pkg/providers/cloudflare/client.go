@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// apiClient 使用 API 令牌调用 Cloudflare API v4
+type apiClient struct {
+	endpoint   string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// apiResponse 是 Cloudflare API 的通用返回结构，实际数据在 result 中
+type apiResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Result     json.RawMessage `json:"result"`
+	ResultInfo struct {
+		Page       int `json:"page"`
+		TotalPages int `json:"total_pages"`
+	} `json:"result_info"`
+}
+
+func newAPIClient(endpoint, apiToken string) *apiClient {
+	return &apiClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		apiToken:   apiToken,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// get 请求 path 并将 result 解析到 result 中，返回值为总页数
+func (c *apiClient) get(path string, query url.Values, result interface{}) (int, error) {
+	requestURL := c.endpoint + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Authorization", "Bearer "+c.apiToken)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return 0, err
+	}
+	var apiResult apiResponse
+	if err = json.Unmarshal(body, &apiResult); err != nil {
+		return 0, fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	if !apiResult.Success {
+		if len(apiResult.Errors) > 0 {
+			return 0, fmt.Errorf("%s: %d %s", path, apiResult.Errors[0].Code, apiResult.Errors[0].Message)
+		}
+		return 0, fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	return apiResult.ResultInfo.TotalPages, json.Unmarshal(apiResult.Result, result)
+}
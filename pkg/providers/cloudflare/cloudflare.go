@@ -0,0 +1,51 @@
+package cloudflare
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	apiToken, ok := options.GetMetadata(utils.APIToken)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.APIToken}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+
+	gologger.Debug().Msg("找到 Cloudflare API 令牌")
+
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "https://api.cloudflare.com/client/v4"
+	}
+	return &Provider{id: id, provider: utils.Cloudflare, apiClient: newAPIClient(endpoint, apiToken)}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	dnsProvider := &dnsProvider{id: p.id, provider: p.provider, apiClient: p.apiClient}
+	dnsList, err := dnsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Cloudflare DNS 解析信息", len(dnsList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(dnsList)
+	finalList.AddSummary("DNS", len(dnsList.GetItems()))
+	return finalList, nil
+}
@@ -0,0 +1,79 @@
+package cloudflare
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+)
+
+type dnsProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+}
+
+type zone struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type dnsRecordItem struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	Proxied bool   `json:"proxied"`
+}
+
+func (d *dnsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 Cloudflare DNS 资源信息")
+	for page := 1; ; page++ {
+		var zones []zone
+		query := url.Values{"page": {strconv.Itoa(page)}, "per_page": {"50"}}
+		totalPages, err := d.apiClient.get("/zones", query, &zones)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range zones {
+			records := d.listDNSRecords(zone)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", zone.Name, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "DNS", ResourceID: zone.ID}, zone.Name)
+		}
+		if page >= totalPages {
+			break
+		}
+	}
+	return list, nil
+}
+
+// listDNSRecords 返回域名下所有 A、AAAA 和 CNAME 解析记录，按照完整的域名聚合
+func (d *dnsProvider) listDNSRecords(zone zone) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	for page := 1; ; page++ {
+		var items []dnsRecordItem
+		query := url.Values{"page": {strconv.Itoa(page)}, "per_page": {"100"}}
+		totalPages, err := d.apiClient.get("/zones/"+zone.ID+"/dns_records", query, &items)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", zone.Name, err)
+			break
+		}
+		for _, item := range items {
+			// 开启代理的记录解析到 Cloudflare 的边缘节点，源站 IP 不会暴露在公网上，只在 proxied_value 中记录
+			if item.Proxied {
+				records.AddProxied(item.Name, item.Type, item.Content, "")
+				continue
+			}
+			records.Add(item.Name, item.Type, item.Content, "")
+		}
+		if page >= totalPages {
+			break
+		}
+	}
+	return records
+}
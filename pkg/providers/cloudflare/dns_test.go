@@ -0,0 +1,77 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDNSReportsProxiedPerValue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			t.Errorf("请求没有携带 API 令牌: %s", r.URL)
+		}
+		var result interface{}
+		totalPages := 1
+		switch r.URL.Path {
+		case "/zones":
+			result = []zone{{ID: "zone-1", Name: "example.com", Status: "active"}}
+		case "/zones/zone-1/dns_records":
+			totalPages = 2
+			if r.URL.Query().Get("page") == "1" {
+				result = []dnsRecordItem{
+					{ID: "r-1", Type: "A", Name: "www.example.com", Content: "104.16.0.1", Proxied: true},
+					{ID: "r-2", Type: "A", Name: "www.example.com", Content: "203.0.113.80"},
+				}
+			} else {
+				result = []dnsRecordItem{
+					{ID: "r-3", Type: "CNAME", Name: "blog.example.com", Content: "example.github.io", Proxied: true},
+					{ID: "r-4", Type: "TXT", Name: "example.com", Content: "v=spf1 -all"},
+				}
+			}
+		default:
+			t.Errorf("未预期的请求: %s", r.URL)
+		}
+		data, _ := json.Marshal(result)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":     true,
+			"result":      json.RawMessage(data),
+			"result_info": map[string]int{"page": 1, "total_pages": totalPages},
+		})
+	}))
+	defer server.Close()
+
+	provider, err := New(schema.OptionBlock{utils.APIToken: "token-1", utils.Endpoint: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := provider.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		items[item.DNSName+item.PublicIPv4+item.PrivateIpv4] = item
+		if item.ResourceID != "zone-1" {
+			t.Errorf("ResourceID 应该为区域 ID: %+v", item)
+		}
+	}
+	if items["104.16.0.1"] != nil {
+		t.Errorf("开启代理的记录值不应该作为资产的地址")
+	}
+	www := items["www.example.com"]
+	if www == nil || www.Metadata["record_value"] != "104.16.0.1, 203.0.113.80" || www.Metadata["proxied_value"] != "104.16.0.1" {
+		t.Fatalf("www.example.com 的解析记录不正确: %+v", www)
+	}
+	// 未开启代理的源站地址仍然需要输出
+	if origin := items["203.0.113.80"]; origin == nil || origin.Metadata["domain"] != "example.com" {
+		t.Errorf("缺少未开启代理的记录值: %v", items)
+	}
+	if blog := items["blog.example.com"]; blog == nil || blog.Metadata["proxied_value"] != "example.github.io" {
+		t.Errorf("blog.example.com 的解析记录不正确: %+v", blog)
+	}
+}
@@ -0,0 +1,197 @@
+package gcp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const readOnlyScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+
+// serviceAccountKey 是服务账号 JSON 密钥文件中用到的字段
+type serviceAccountKey struct {
+	ProjectID   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// apiClient 使用服务账号签发的 JWT 换取访问令牌后调用 Google Cloud REST API
+type apiClient struct {
+	endpoint    string
+	tokenURI    string
+	clientEmail string
+	privateKey  *rsa.PrivateKey
+	httpClient  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type apiError struct {
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newAPIClient(endpoint string) *apiClient {
+	return &apiClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// withAccessToken 直接使用已有的访问令牌，例如 gcloud auth print-access-token 的输出
+func (c *apiClient) withAccessToken(accessToken string) {
+	c.accessToken = accessToken
+	c.expiresAt = time.Now().Add(time.Hour)
+}
+
+// withServiceAccountKey 使用服务账号密钥签发 JWT，tokenURI 为空时使用密钥文件中的地址
+func (c *apiClient) withServiceAccountKey(key *serviceAccountKey, tokenURI string) error {
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return errors.New("无法解析服务账号密钥中的 private_key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+	}
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return errors.New("服务账号密钥不是 RSA 私钥")
+	}
+	if tokenURI == "" {
+		tokenURI = key.TokenURI
+	}
+	if tokenURI == "" {
+		tokenURI = "https://oauth2.googleapis.com/token"
+	}
+	c.tokenURI = tokenURI
+	c.clientEmail = key.ClientEmail
+	c.privateKey = privateKey
+	return nil
+}
+
+// token 返回缓存的访问令牌，过期前一分钟会重新获取
+func (c *apiClient) token() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.accessToken != "" && (c.privateKey == nil || time.Now().Before(c.expiresAt)) {
+		return c.accessToken, nil
+	}
+	if c.privateKey == nil {
+		return "", errors.New("没有可用的 GCP 访问凭证")
+	}
+	assertion, err := c.signJWT()
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	response, err := c.httpClient.PostForm(c.tokenURI, form)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("获取 GCP 访问令牌失败 %s: %s", response.Status, bytes.TrimSpace(body))
+	}
+	var result tokenResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+	c.accessToken = result.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+	return c.accessToken, nil
+}
+
+// signJWT 生成使用 RS256 签名的 JWT
+func (c *apiClient) signJWT() (string, error) {
+	now := time.Now()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss":   c.clientEmail,
+		"scope": readOnlyScope,
+		"aud":   c.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// get 请求 endpoint 下的 path，并将返回的 JSON 解析到 result 中
+func (c *apiClient) get(path string, query url.Values, result interface{}) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	requestURL := c.endpoint + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		var apiErr apiError
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error != nil {
+			return fmt.Errorf("%s: %d %s", path, apiErr.Error.Code, apiErr.Error.Message)
+		}
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	return json.Unmarshal(body, result)
+}
+
+// resourceName 返回资源 URL 的最后一段，例如 zones/us-central1-a 返回 us-central1-a
+func resourceName(selfLink string) string {
+	return selfLink[strings.LastIndex(selfLink, "/")+1:]
+}
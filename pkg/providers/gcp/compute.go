@@ -0,0 +1,87 @@
+package gcp
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type computeProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	projectID string
+}
+
+type aggregatedInstancesResponse struct {
+	Items map[string]struct {
+		Instances []struct {
+			ID                string `json:"id"`
+			Name              string `json:"name"`
+			Zone              string `json:"zone"`
+			Status            string `json:"status"`
+			NetworkInterfaces []struct {
+				NetworkIP     string `json:"networkIP"`
+				IPv6Address   string `json:"ipv6Address"`
+				AccessConfigs []struct {
+					NatIP string `json:"natIP"`
+				} `json:"accessConfigs"`
+				IPv6AccessConfigs []struct {
+					ExternalIPv6 string `json:"externalIpv6"`
+				} `json:"ipv6AccessConfigs"`
+			} `json:"networkInterfaces"`
+		} `json:"instances"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (d *computeProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 GCP Compute Engine 资源信息")
+	query := url.Values{"maxResults": {"500"}}
+	for {
+		var response aggregatedInstancesResponse
+		err := d.apiClient.get("/compute/v1/projects/"+d.projectID+"/aggregated/instances", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 GCP Compute Engine 实例列表: %s", err)
+			break
+		}
+		for _, scoped := range response.Items {
+			for _, instance := range scoped.Instances {
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+					ipv6s        []string
+				)
+				for _, networkInterface := range instance.NetworkInterfaces {
+					privateIPv4s = append(privateIPv4s, networkInterface.NetworkIP)
+					ipv6s = append(ipv6s, networkInterface.IPv6Address)
+					for _, accessConfig := range networkInterface.AccessConfigs {
+						publicIPv4s = append(publicIPv4s, accessConfig.NatIP)
+					}
+					for _, accessConfig := range networkInterface.IPv6AccessConfigs {
+						ipv6s = append(ipv6s, accessConfig.ExternalIPv6)
+					}
+				}
+				list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "Compute",
+					Region:       resourceName(instance.Zone),
+					ResourceID:   instance.ID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					IPv6s:        ipv6s,
+					Public:       len(publicIPv4s) > 0,
+					Metadata:     map[string]string{"name": instance.Name, "status": instance.Status},
+				})
+			}
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+	return list, nil
+}
@@ -0,0 +1,91 @@
+package gcp
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strings"
+)
+
+type dnsProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	projectID string
+}
+
+type listManagedZonesResponse struct {
+	ManagedZones []struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		DNSName    string `json:"dnsName"`
+		Visibility string `json:"visibility"`
+	} `json:"managedZones"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type listResourceRecordSetsResponse struct {
+	RRSets []struct {
+		Name    string   `json:"name"`
+		Type    string   `json:"type"`
+		RRDatas []string `json:"rrdatas"`
+	} `json:"rrsets"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (d *dnsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 GCP Cloud DNS 资源信息")
+	query := url.Values{}
+	for {
+		var response listManagedZonesResponse
+		err := d.apiClient.get("/dns/v1/projects/"+d.projectID+"/managedZones", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 GCP Cloud DNS 托管区域列表: %s", err)
+			break
+		}
+		for _, zone := range response.ManagedZones {
+			// 私有托管区域只能在 VPC 内解析
+			if zone.Visibility == "private" {
+				continue
+			}
+			domainName := strings.TrimSuffix(zone.DNSName, ".")
+			records := d.listResourceRecordSets(zone.Name, domainName)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", domainName, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "CloudDNS", ResourceID: zone.ID}, domainName)
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+	return list, nil
+}
+
+// listResourceRecordSets 返回托管区域下所有 A、AAAA 和 CNAME 解析记录，按照完整的域名聚合
+func (d *dnsProvider) listResourceRecordSets(zoneName, domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	query := url.Values{}
+	for {
+		var response listResourceRecordSetsResponse
+		err := d.apiClient.get("/dns/v1/projects/"+d.projectID+"/managedZones/"+zoneName+"/rrsets", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+			break
+		}
+		for _, recordSet := range response.RRSets {
+			fqdn := strings.TrimSuffix(recordSet.Name, ".")
+			for _, value := range recordSet.RRDatas {
+				records.Add(fqdn, recordSet.Type, value, "")
+			}
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+	return records
+}
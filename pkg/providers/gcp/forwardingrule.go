@@ -0,0 +1,67 @@
+package gcp
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type forwardingRuleProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	projectID string
+}
+
+type aggregatedForwardingRulesResponse struct {
+	Items map[string]struct {
+		ForwardingRules []struct {
+			ID                  string `json:"id"`
+			Name                string `json:"name"`
+			IPAddress           string `json:"IPAddress"`
+			IPProtocol          string `json:"IPProtocol"`
+			PortRange           string `json:"portRange"`
+			LoadBalancingScheme string `json:"loadBalancingScheme"`
+		} `json:"forwardingRules"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (d *forwardingRuleProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 GCP 转发规则资源信息")
+	query := url.Values{"maxResults": {"500"}}
+	for {
+		var response aggregatedForwardingRulesResponse
+		err := d.apiClient.get("/compute/v1/projects/"+d.projectID+"/aggregated/forwardingRules", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 GCP 转发规则列表: %s", err)
+			break
+		}
+		// items 的 key 为 regions/us-central1 或 global，全局负载均衡的转发规则在 global 下
+		for scope, scoped := range response.Items {
+			for _, rule := range scoped.ForwardingRules {
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "ForwardingRule",
+					Region:     resourceName(scope),
+					ResourceID: rule.ID,
+					PublicIPv4: rule.IPAddress,
+					Metadata: map[string]string{
+						"name":                  rule.Name,
+						"protocol":              rule.IPProtocol,
+						"port_range":            rule.PortRange,
+						"load_balancing_scheme": rule.LoadBalancingScheme,
+					},
+				})
+			}
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+	return list, nil
+}
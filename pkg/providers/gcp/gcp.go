@@ -0,0 +1,107 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"os"
+)
+
+type Provider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	projectID string
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	id, _ := options.GetMetadata(utils.Id)
+
+	// 所有 API 都可以通过 www.googleapis.com 访问，配置 endpoint 后可以请求本地模拟服务
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "https://www.googleapis.com"
+	}
+	apiClient := newAPIClient(endpoint)
+	projectID, _ := options.GetMetadata(utils.ProjectId)
+
+	if credentialsFile, ok := options.GetMetadata(utils.CredentialsFile); ok {
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		var key serviceAccountKey
+		if err = json.Unmarshal(data, &key); err != nil {
+			return nil, err
+		}
+		authEndpoint, _ := options.GetMetadata(utils.AuthEndpoint)
+		if err = apiClient.withServiceAccountKey(&key, authEndpoint); err != nil {
+			return nil, err
+		}
+		if projectID == "" {
+			projectID = key.ProjectID
+		}
+		gologger.Debug().Msg("找到 GCP 服务账号密钥")
+	} else if accessToken, ok := options.GetMetadata(utils.SessionToken); ok {
+		apiClient.withAccessToken(accessToken)
+		gologger.Debug().Msg("找到 GCP 临时访问令牌")
+	} else {
+		return nil, &utils.ErrNoSuchKey{Name: utils.CredentialsFile}
+	}
+	if projectID == "" {
+		return nil, &utils.ErrNoSuchKey{Name: utils.ProjectId}
+	}
+
+	return &Provider{id: id, provider: utils.GCP, apiClient: apiClient, projectID: projectID}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	computeProvider := &computeProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projectID: p.projectID}
+	computeList, err := computeProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 GCP Compute Engine 信息", len(computeList.GetItems()))
+
+	forwardingRuleProvider := &forwardingRuleProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projectID: p.projectID}
+	forwardingRuleList, err := forwardingRuleProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 GCP 转发规则信息", len(forwardingRuleList.GetItems()))
+
+	gcsProvider := &gcsProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projectID: p.projectID}
+	gcsList, err := gcsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 GCP GCS 信息", len(gcsList.GetItems()))
+
+	dnsProvider := &dnsProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projectID: p.projectID}
+	dnsList, err := dnsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 GCP Cloud DNS 解析信息", len(dnsList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(computeList)
+	finalList.Merge(forwardingRuleList)
+	finalList.Merge(gcsList)
+	finalList.Merge(dnsList)
+	finalList.AddSummary("Compute", len(computeList.GetItems()))
+	finalList.AddSummary("ForwardingRule", len(forwardingRuleList.GetItems()))
+	finalList.AddSummary("GCS", len(gcsList.GetItems()))
+	finalList.AddSummary("CloudDNS", len(dnsList.GetItems()))
+	return finalList, nil
+}
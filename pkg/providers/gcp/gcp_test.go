@@ -0,0 +1,204 @@
+package gcp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newTestProvider 生成服务账号密钥文件，并把令牌和 API 请求都发送到 httptest 模拟的服务，
+// pages 的 key 为请求路径（包含查询参数），value 为返回的 JSON
+func newTestProvider(t *testing.T, tokenRequests *int32, pages map[string]interface{}) *Provider {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			atomic.AddInt32(tokenRequests, 1)
+			if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+				t.Errorf("grant_type 不正确: %s", r.FormValue("grant_type"))
+			}
+			claims := verifyJWT(t, r.FormValue("assertion"), &privateKey.PublicKey)
+			if claims["iss"] != "lc@project-1.iam.gserviceaccount.com" || claims["scope"] != readOnlyScope ||
+				claims["aud"] != "http://"+r.Host+"/token" {
+				t.Errorf("JWT 的内容不正确: %v", claims)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token-1", "expires_in": 3600})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			t.Errorf("请求没有携带访问令牌: %s", r.URL)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		page, ok := pages[r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"code": 404, "message": r.URL.RequestURI()}})
+			return
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := json.Marshal(serviceAccountKey{
+		ProjectID:   "project-1",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail: "lc@project-1.iam.gserviceaccount.com",
+		TokenURI:    server.URL + "/token",
+	})
+	credentialsFile := filepath.Join(t.TempDir(), "key.json")
+	if err = os.WriteFile(credentialsFile, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+	provider, err := New(schema.OptionBlock{utils.CredentialsFile: credentialsFile, utils.Endpoint: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provider.projectID != "project-1" {
+		t.Errorf("没有使用密钥文件中的项目 ID: %s", provider.projectID)
+	}
+	return provider
+}
+
+// verifyJWT 校验 RS256 签名并返回 JWT 中的 claims
+func verifyJWT(t *testing.T, assertion string, publicKey *rsa.PublicKey) map[string]interface{} {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT 的格式不正确: %s", assertion)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hash[:], signature); err != nil {
+		t.Fatalf("JWT 签名校验失败: %s", err)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims map[string]interface{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+	return claims
+}
+
+func TestComputeResourcesWithPageToken(t *testing.T) {
+	var tokenRequests int32
+	instancesPath := "/compute/v1/projects/project-1/aggregated/instances?maxResults=500"
+	provider := newTestProvider(t, &tokenRequests, map[string]interface{}{
+		instancesPath: map[string]interface{}{
+			"items": map[string]interface{}{
+				"zones/us-central1-a": map[string]interface{}{
+					"instances": []map[string]interface{}{{
+						"id": "1001", "name": "web", "status": "RUNNING",
+						"zone": "https://www.googleapis.com/compute/v1/projects/project-1/zones/us-central1-a",
+						"networkInterfaces": []map[string]interface{}{{
+							"networkIP":     "10.128.0.2",
+							"accessConfigs": []map[string]string{{"natIP": "34.0.0.1"}},
+						}},
+					}},
+				},
+				// 没有实例的区域只会返回 warning
+				"zones/us-east1-b": map[string]interface{}{},
+			},
+			"nextPageToken": "page2",
+		},
+		instancesPath + "&pageToken=page2": map[string]interface{}{
+			"items": map[string]interface{}{
+				"zones/europe-west1-b": map[string]interface{}{
+					"instances": []map[string]interface{}{{
+						"id": "1002", "name": "db", "status": "RUNNING",
+						"zone":              "https://www.googleapis.com/compute/v1/projects/project-1/zones/europe-west1-b",
+						"networkInterfaces": []map[string]interface{}{{"networkIP": "10.132.0.2"}},
+					}},
+				},
+			},
+		},
+	})
+	computeProvider := &computeProvider{id: "test", provider: utils.GCP, apiClient: provider.apiClient, projectID: provider.projectID}
+	list, err := computeProvider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addresses := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		addresses[item.PublicIPv4+item.PrivateIpv4] = item
+	}
+	if len(addresses) != 3 {
+		t.Fatalf("应该获取到两页共 3 个地址，实际为: %v", addresses)
+	}
+	if web := addresses["34.0.0.1"]; web == nil || !web.Public || web.ResourceID != "1001" || web.Region != "us-central1-a" || web.Metadata["name"] != "web" {
+		t.Errorf("实例的公网地址不正确: %+v", web)
+	}
+	if db := addresses["10.132.0.2"]; db == nil || db.Public || db.Region != "europe-west1-b" {
+		t.Errorf("第二页实例的内网地址不正确: %+v", db)
+	}
+	// 访问令牌在有效期内会被缓存
+	if tokenRequests != 1 {
+		t.Errorf("应该只获取一次访问令牌，实际为 %d 次", tokenRequests)
+	}
+}
+
+func TestDNSResources(t *testing.T) {
+	var tokenRequests int32
+	zonePath := "/dns/v1/projects/project-1/managedZones"
+	provider := newTestProvider(t, &tokenRequests, map[string]interface{}{
+		zonePath: map[string]interface{}{
+			"managedZones": []map[string]string{
+				{"id": "1", "name": "example", "dnsName": "example.com.", "visibility": "public"},
+				{"id": "2", "name": "internal", "dnsName": "internal.", "visibility": "private"},
+			},
+		},
+		zonePath + "/example/rrsets": map[string]interface{}{
+			"rrsets":        []map[string]interface{}{{"name": "www.example.com.", "type": "A", "rrdatas": []string{"34.0.0.1"}}},
+			"nextPageToken": "page2",
+		},
+		zonePath + "/example/rrsets?pageToken=page2": map[string]interface{}{
+			"rrsets": []map[string]interface{}{
+				{"name": "www.example.com.", "type": "AAAA", "rrdatas": []string{"2600:1900::1"}},
+				{"name": "example.com.", "type": "MX", "rrdatas": []string{"10 mx.example.com."}},
+			},
+		},
+	})
+	dnsProvider := &dnsProvider{id: "test", provider: utils.GCP, apiClient: provider.apiClient, projectID: provider.projectID}
+	list, err := dnsProvider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var www *schema.Resource
+	for _, item := range list.GetItems() {
+		if item.DNSName == "www.example.com" {
+			www = item
+		}
+	}
+	if len(list.GetItems()) != 3 || www == nil {
+		t.Fatalf("应该获取到 www.example.com 的域名、IPv4 和 IPv6 地址: %v", list.GetItems())
+	}
+	if www.ResourceID != "1" || www.Metadata["record_type"] != "A, AAAA" || www.Metadata["record_value"] != "34.0.0.1, 2600:1900::1" {
+		t.Errorf("解析记录不正确: %+v", www)
+	}
+}
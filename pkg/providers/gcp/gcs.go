@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strings"
+)
+
+type gcsProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	projectID string
+}
+
+type listBucketsResponse struct {
+	Items []struct {
+		Name     string `json:"name"`
+		Location string `json:"location"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (d *gcsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 GCP GCS 资源信息")
+	query := url.Values{"project": {d.projectID}, "maxResults": {"1000"}}
+	for {
+		var response listBucketsResponse
+		if err := d.apiClient.get("/storage/v1/b", query, &response); err != nil {
+			return nil, err
+		}
+		for _, bucket := range response.Items {
+			list.Append(&schema.Resource{
+				ID:         d.id,
+				Public:     true,
+				DNSName:    bucket.Name + ".storage.googleapis.com",
+				Provider:   d.provider,
+				Service:    "GCS",
+				Region:     strings.ToLower(bucket.Location),
+				ResourceID: bucket.Name,
+			})
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+	return list, nil
+}
@@ -0,0 +1,86 @@
+package huawei
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net"
+	"net/url"
+	"sync"
+)
+
+type cceProvider struct {
+	id        string
+	provider  string
+	apiClient *apig.Client
+	projects  []apig.Project
+	list      *schema.Resources
+}
+
+type listClustersResponse struct {
+	Items []struct {
+		Metadata struct {
+			UID  string `json:"uid"`
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Status struct {
+			Endpoints []struct {
+				URL  string `json:"url"`
+				Type string `json:"type"`
+			} `json:"endpoints"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func (d *cceProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.projects, d.listClusters)
+	return d.list, nil
+}
+
+func (d *cceProvider) listClusters(ch <-chan apig.Project, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的华为云 CCE 资源信息", item.Name)
+		var response listClustersResponse
+		err = d.apiClient.Get("cce."+item.Region+".myhuaweicloud.com", "/api/v3/projects/"+item.ID+"/clusters", nil, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下的 CCE 资源: %s", item.Name, err)
+			continue
+		}
+		if len(response.Items) > 0 {
+			gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 CCE 资源", item.Name, len(response.Items))
+		}
+		for _, cluster := range response.Items {
+			// API Server 地址的格式为 https://{ip}:5443，Internal 为内网地址，External 为公网地址
+			for _, endpoint := range cluster.Status.Endpoints {
+				endpointURL, parseErr := url.Parse(endpoint.URL)
+				if parseErr != nil || endpointURL.Hostname() == "" {
+					continue
+				}
+				resource := &schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "CCE",
+					Region:     item.Region,
+					ResourceID: cluster.Metadata.UID,
+					Public:     endpoint.Type == "External",
+					Metadata: map[string]string{
+						"name":     cluster.Metadata.Name,
+						"endpoint": endpoint.URL,
+					},
+				}
+				host := endpointURL.Hostname()
+				if net.ParseIP(host) != nil {
+					resource.PublicIPv4s = []string{host}
+				} else {
+					resource.DNSName = host
+				}
+				d.list.Append(resource)
+			}
+		}
+	}
+	return err
+}
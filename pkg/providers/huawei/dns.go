@@ -0,0 +1,89 @@
+package huawei
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type dnsProvider struct {
+	id        string
+	provider  string
+	apiClient *apig.Client
+}
+
+type listZonesResponse struct {
+	Zones []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"zones"`
+	Metadata struct {
+		TotalCount int `json:"total_count"`
+	} `json:"metadata"`
+}
+
+type listRecordSetsResponse struct {
+	RecordSets []struct {
+		ID      string   `json:"id"`
+		Name    string   `json:"name"`
+		Type    string   `json:"type"`
+		Records []string `json:"records"`
+	} `json:"recordsets"`
+	Metadata struct {
+		TotalCount int `json:"total_count"`
+	} `json:"metadata"`
+}
+
+func (d *dnsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取华为云 DNS 资源信息")
+	for offset := 0; ; offset += 500 {
+		var response listZonesResponse
+		query := url.Values{"type": {"public"}, "offset": {strconv.Itoa(offset)}, "limit": {"500"}}
+		err := d.apiClient.Get("dns.myhuaweicloud.com", "/v2/zones", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取华为云 DNS 域名列表: %s", err)
+			break
+		}
+		for _, zone := range response.Zones {
+			domainName := strings.TrimSuffix(zone.Name, ".")
+			records := d.listRecordSets(zone.ID, domainName)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", domainName, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "DNS"}, domainName)
+		}
+		if offset+500 >= response.Metadata.TotalCount {
+			break
+		}
+	}
+	return list, nil
+}
+
+// listRecordSets 返回域名下所有 A、AAAA 和 CNAME 解析记录，按照完整的域名聚合
+func (d *dnsProvider) listRecordSets(zoneId, domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	for offset := 0; ; offset += 500 {
+		var response listRecordSetsResponse
+		query := url.Values{"offset": {strconv.Itoa(offset)}, "limit": {"500"}}
+		err := d.apiClient.Get("dns.myhuaweicloud.com", "/v2/zones/"+zoneId+"/recordsets", query, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+			break
+		}
+		for _, recordSet := range response.RecordSets {
+			fqdn := strings.TrimSuffix(recordSet.Name, ".")
+			for _, value := range recordSet.Records {
+				records.Add(fqdn, recordSet.Type, value, recordSet.ID)
+			}
+		}
+		if offset+500 >= response.Metadata.TotalCount {
+			break
+		}
+	}
+	return records
+}
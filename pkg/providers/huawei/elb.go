@@ -0,0 +1,89 @@
+package huawei
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"sync"
+)
+
+type elbProvider struct {
+	id        string
+	provider  string
+	apiClient *apig.Client
+	projects  []apig.Project
+	list      *schema.Resources
+}
+
+type listLoadBalancersResponse struct {
+	LoadBalancers []struct {
+		ID             string `json:"id"`
+		Name           string `json:"name"`
+		VipAddress     string `json:"vip_address"`
+		IPv6VipAddress string `json:"ipv6_vip_address"`
+		Eips           []struct {
+			EipAddress string `json:"eip_address"`
+		} `json:"eips"`
+		PublicIPs []struct {
+			PublicIPAddress string `json:"publicip_address"`
+		} `json:"publicips"`
+	} `json:"loadbalancers"`
+	PageInfo struct {
+		NextMarker string `json:"next_marker"`
+	} `json:"page_info"`
+}
+
+func (d *elbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.projects, d.listLoadBalancers)
+	return d.list, nil
+}
+
+func (d *elbProvider) listLoadBalancers(ch <-chan apig.Project, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的华为云 ELB 资源信息", item.Name)
+		query := url.Values{"limit": {"100"}}
+		for {
+			var response listLoadBalancersResponse
+			err = d.apiClient.Get("elb."+item.Region+".myhuaweicloud.com", "/v3/"+item.ID+"/elb/loadbalancers", query, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 ELB 资源: %s", item.Name, err)
+				break
+			}
+			if len(response.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 ELB 资源", item.Name, len(response.LoadBalancers))
+			}
+			for _, loadBalancer := range response.LoadBalancers {
+				var eips []string
+				for _, eip := range loadBalancer.Eips {
+					eips = append(eips, eip.EipAddress)
+				}
+				for _, publicIP := range loadBalancer.PublicIPs {
+					eips = append(eips, publicIP.PublicIPAddress)
+				}
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "ELB",
+					Region:      item.Region,
+					ResourceID:  loadBalancer.ID,
+					PrivateIpv4: loadBalancer.VipAddress,
+					EIPs:        eips,
+					IPv6s:       []string{loadBalancer.IPv6VipAddress},
+					Public:      len(eips) > 0,
+					Metadata:    map[string]string{"name": loadBalancer.Name},
+				})
+			}
+			// next_marker 始终为本页最后一条记录，返回的数量小于 limit 时说明已经是最后一页
+			if len(response.LoadBalancers) < 100 || response.PageInfo.NextMarker == "" {
+				break
+			}
+			query.Set("marker", response.PageInfo.NextMarker)
+		}
+	}
+	return err
+}
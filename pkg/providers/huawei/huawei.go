@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
 	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
 	"github.com/wgpsec/lc/pkg/schema"
 	"github.com/wgpsec/lc/utils"
 )
@@ -12,6 +13,8 @@ type Provider struct {
 	id        string
 	provider  string
 	obsClient *obs.ObsClient
+	apiClient *apig.Client
+	projects  []apig.Project
 }
 
 func New(options schema.OptionBlock) (*Provider, error) {
@@ -47,7 +50,15 @@ func New(options schema.OptionBlock) (*Provider, error) {
 		return nil, err
 	}
 
-	return &Provider{provider: utils.Huawei, id: id, obsClient: obsClient}, nil
+	// projects
+	apiClient := apig.NewClient(accessKeyID, accessKeySecret, sessionToken)
+	projects, err := apig.ListProjects(apiClient, "iam.myhuaweicloud.com")
+	if err != nil {
+		// 没有 IAM 权限时仍然可以获取 OBS 资源
+		gologger.Debug().Msgf("无法获取华为云项目列表: %s", err)
+	}
+
+	return &Provider{provider: utils.Huawei, id: id, obsClient: obsClient, apiClient: apiClient, projects: projects}, nil
 }
 
 func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
@@ -58,8 +69,64 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条华为云 OBS 信息", len(buckets.GetItems()))
+
+	ecsProvider := &apig.ECSProvider{ID: p.id, Provider: p.provider, Name: "华为云", Endpoint: "myhuaweicloud.com", Client: p.apiClient, Projects: p.projects}
+	ecsList, err := ecsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 ECS 信息", len(ecsList.GetItems()))
+
+	eipProvider := &apig.EIPProvider{ID: p.id, Provider: p.provider, Name: "华为云", Endpoint: "myhuaweicloud.com", Client: p.apiClient, Projects: p.projects}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 EIP 信息", len(eipList.GetItems()))
+
+	elbProvider := &elbProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projects: p.projects}
+	elbList, err := elbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 ELB 信息", len(elbList.GetItems()))
+
+	dnsProvider := &dnsProvider{id: p.id, provider: p.provider, apiClient: p.apiClient}
+	dnsList, err := dnsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 DNS 解析信息", len(dnsList.GetItems()))
+
+	rdsProvider := &rdsProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projects: p.projects}
+	rdsList, err := rdsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 RDS 信息", len(rdsList.GetItems()))
+
+	cceProvider := &cceProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, projects: p.projects}
+	cceList, err := cceProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条华为云 CCE 信息", len(cceList.GetItems()))
+
 	finalList := schema.NewResources()
 	finalList.Merge(buckets)
+	finalList.Merge(ecsList)
+	finalList.Merge(eipList)
+	finalList.Merge(elbList)
+	finalList.Merge(dnsList)
+	finalList.Merge(rdsList)
+	finalList.Merge(cceList)
+	finalList.AddSummary("OBS", len(buckets.GetItems()))
+	finalList.AddSummary("ECS", len(ecsList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("ELB", len(elbList.GetItems()))
+	finalList.AddSummary("DNS", len(dnsList.GetItems()))
+	finalList.AddSummary("RDS", len(rdsList.GetItems()))
+	finalList.AddSummary("CCE", len(cceList.GetItems()))
 	return finalList, nil
 }
 
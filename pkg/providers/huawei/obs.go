@@ -25,10 +25,13 @@ func (d *obsProvider) GetResource(ctx context.Context) (*schema.Resources, error
 		endpointBuilder.WriteString(".obs." + bucket.Location)
 		endpointBuilder.WriteString(".myhuaweicloud.com")
 		list.Append(&schema.Resource{
-			ID:       d.id,
-			Public:   true,
-			DNSName:  endpointBuilder.String(),
-			Provider: d.provider,
+			ID:         d.id,
+			Public:     true,
+			DNSName:    endpointBuilder.String(),
+			Provider:   d.provider,
+			Service:    "OBS",
+			Region:     bucket.Location,
+			ResourceID: bucket.Name,
 		})
 	}
 	return list, nil
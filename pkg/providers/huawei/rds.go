@@ -0,0 +1,93 @@
+package huawei
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type rdsProvider struct {
+	id        string
+	provider  string
+	apiClient *apig.Client
+	projects  []apig.Project
+	list      *schema.Resources
+}
+
+type listInstancesResponse struct {
+	Instances []struct {
+		ID         string   `json:"id"`
+		Name       string   `json:"name"`
+		Port       int      `json:"port"`
+		PublicIPs  []string `json:"public_ips"`
+		PrivateIPs []string `json:"private_ips"`
+		Datastore  struct {
+			Type    string `json:"type"`
+			Version string `json:"version"`
+		} `json:"datastore"`
+	} `json:"instances"`
+	TotalCount int `json:"total_count"`
+}
+
+func (d *rdsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.projects, d.listInstances)
+	return d.list, nil
+}
+
+func (d *rdsProvider) listInstances(ch <-chan apig.Project, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的华为云 RDS 资源信息", item.Name)
+		for offset := 0; ; offset += 100 {
+			var response listInstancesResponse
+			query := url.Values{"offset": {strconv.Itoa(offset)}, "limit": {"100"}}
+			err = d.apiClient.Get("rds."+item.Region+".myhuaweicloud.com", "/v3/"+item.ID+"/instances", query, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 RDS 资源: %s", item.Name, err)
+				break
+			}
+			if len(response.Instances) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 RDS 资源", item.Name, len(response.Instances))
+			}
+			for _, instance := range response.Instances {
+				metadata := map[string]string{
+					"name":   instance.Name,
+					"engine": instance.Datastore.Type + " " + instance.Datastore.Version,
+					"port":   strconv.Itoa(instance.Port),
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "RDS",
+					Region:       item.Region,
+					ResourceID:   instance.ID,
+					PrivateIpv4s: instance.PrivateIPs,
+					Metadata:     metadata,
+				})
+				// 只有绑定了 EIP 的实例才会返回 public_ips，未绑定的实例只保留内网地址
+				if len(instance.PublicIPs) > 0 {
+					d.list.Append(&schema.Resource{
+						ID:          d.id,
+						Provider:    d.provider,
+						Service:     "RDS",
+						Region:      item.Region,
+						ResourceID:  instance.ID,
+						PublicIPv4s: instance.PublicIPs,
+						Public:      true,
+						Metadata:    metadata,
+					})
+				}
+			}
+			if offset+100 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+package jdcloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const signAlgorithm = "JDCLOUD2-HMAC-SHA256"
+
+// openAPIClient 使用 JDCLOUD2-HMAC-SHA256 签名调用京东云 OpenAPI
+type openAPIClient struct {
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+}
+
+// openAPIResponse 是京东云 OpenAPI 的通用返回结构
+type openAPIResponse struct {
+	RequestId string          `json:"requestId"`
+	Result    json.RawMessage `json:"result"`
+	Error     *struct {
+		Code    int    `json:"code"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newOpenAPIClient(accessKey, secretKey, sessionToken string) *openAPIClient {
+	return &openAPIClient{
+		accessKey:    accessKey,
+		secretKey:    secretKey,
+		sessionToken: sessionToken,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// get 调用 {service}.jdcloud-api.com 的 GET 接口，并将 result 解析到 result 中
+func (c *openAPIClient) get(region, service, path string, query url.Values, result interface{}) error {
+	requestURL := "https://" + service + ".jdcloud-api.com" + path
+	if len(query) > 0 {
+		requestURL += "?" + canonicalQueryString(query)
+	}
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	c.sign(request, region, service, nil)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	var apiResponse openAPIResponse
+	if err = json.Unmarshal(body, &apiResponse); err != nil {
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	if apiResponse.Error != nil {
+		return fmt.Errorf("%s: %s %s", path, apiResponse.Error.Status, apiResponse.Error.Message)
+	}
+	return json.Unmarshal(apiResponse.Result, result)
+}
+
+// sign 为请求添加 x-jdcloud-date、x-jdcloud-nonce 和 Authorization 头
+func (c *openAPIClient) sign(request *http.Request, region, service string, body []byte) {
+	now := time.Now().UTC()
+	jdDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	request.Header.Set("x-jdcloud-date", jdDate)
+	request.Header.Set("x-jdcloud-nonce", newNonce())
+	if c.sessionToken != "" {
+		request.Header.Set("x-jdcloud-security-token", c.sessionToken)
+	}
+
+	signedHeaders := []string{"host"}
+	for key := range request.Header {
+		signedHeaders = append(signedHeaders, strings.ToLower(key))
+	}
+	sort.Strings(signedHeaders)
+	var canonicalHeaders strings.Builder
+	for _, key := range signedHeaders {
+		value := request.Header.Get(key)
+		if key == "host" {
+			value = request.URL.Host
+		}
+		canonicalHeaders.WriteString(key + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	credentialScope := shortDate + "/" + region + "/" + service + "/jdcloud2_request"
+	stringToSign := signAlgorithm + "\n" + jdDate + "\n" + credentialScope + "\n" + hex.EncodeToString(requestHash[:])
+
+	kDate := hmacSHA256([]byte("JDCLOUD2"+c.secretKey), shortDate)
+	kRegion := hmacSHA256(kDate, region)
+	kService := hmacSHA256(kRegion, service)
+	kSigning := hmacSHA256(kService, "jdcloud2_request")
+	signature := hex.EncodeToString(hmacSHA256(kSigning, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signAlgorithm, c.accessKey, credentialScope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func canonicalQueryString(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, url.QueryEscape(key)+"="+strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func newNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,74 @@
+package jdcloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type eipProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	list          *schema.Resources
+}
+
+type describeElasticIpsResult struct {
+	TotalCount int `json:"totalCount"`
+	ElasticIps []struct {
+		ElasticIpId      string `json:"elasticIpId"`
+		ElasticIpAddress string `json:"elasticIpAddress"`
+		InstanceId       string `json:"instanceId"`
+		InstanceType     string `json:"instanceType"`
+	} `json:"elasticIps"`
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.describeElasticIps)
+	return d.list, nil
+}
+
+func (d *eipProvider) describeElasticIps(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的京东云 EIP 资源信息", region)
+		for pageNumber := 1; ; pageNumber++ {
+			var result describeElasticIpsResult
+			query := url.Values{"pageNumber": {strconv.Itoa(pageNumber)}, "pageSize": {"100"}}
+			err = d.openAPIClient.get(region, "vpc", "/v1/regions/"+region+"/elasticIps", query, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 EIP 资源: %s", region, err)
+				break
+			}
+			if len(result.ElasticIps) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(result.ElasticIps))
+			}
+			for _, eip := range result.ElasticIps {
+				metadata := map[string]string{}
+				if eip.InstanceId != "" {
+					metadata["instance_id"] = eip.InstanceId
+					metadata["instance_type"] = eip.InstanceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     region,
+					ResourceID: eip.ElasticIpId,
+					PublicIPv4: eip.ElasticIpAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if pageNumber*100 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,91 @@
+package jdcloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id            string
+	provider      string
+	config        providerConfig
+	openAPIClient *openAPIClient
+}
+
+type providerConfig struct {
+	accessKeyID     string
+	accessKeySecret string
+	sessionToken    string
+}
+
+// regions 是京东云的公有云区域
+var regions = []string{"cn-north-1", "cn-east-1", "cn-east-2", "cn-south-1"}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	accessKeySecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	sessionToken, okST := options.GetMetadata(utils.SessionToken)
+
+	if okST {
+		gologger.Debug().Msg("找到京东云临时访问凭证")
+	} else {
+		gologger.Debug().Msg("找到京东云永久访问凭证")
+	}
+
+	config := providerConfig{
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		sessionToken:    sessionToken,
+	}
+	openAPIClient := newOpenAPIClient(accessKeyID, accessKeySecret, sessionToken)
+	return &Provider{id: id, provider: utils.JDCloud, config: config, openAPIClient: openAPIClient}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	vmProvider := &vmProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient}
+	vmList, err := vmProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条京东云 VM 信息", len(vmList.GetItems()))
+
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条京东云 EIP 信息", len(eipList.GetItems()))
+
+	ossProvider := &ossProvider{id: p.id, provider: p.provider, config: p.config}
+	ossList, err := ossProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条京东云 OSS 信息", len(ossList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(vmList)
+	finalList.Merge(eipList)
+	finalList.Merge(ossList)
+	finalList.AddSummary("VM", len(vmList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("OSS", len(ossList.GetItems()))
+	return finalList, nil
+}
@@ -0,0 +1,30 @@
+package jdcloud
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/s3"
+	"github.com/wgpsec/lc/pkg/schema"
+)
+
+type ossProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+}
+
+// GetResource 通过 OSS 的 S3 兼容接口获取存储桶，ListBuckets 会返回所有区域的存储桶
+func (d *ossProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	gologger.Debug().Msg("正在获取京东云 OSS 资源信息")
+	lister := &s3.BucketLister{
+		ID:          d.id,
+		Provider:    d.provider,
+		Service:     "OSS",
+		Endpoint:    "https://s3.cn-north-1.jdcloud-oss.com",
+		Region:      "cn-north-1",
+		Credentials: credentials.NewStaticCredentials(d.config.accessKeyID, d.config.accessKeySecret, d.config.sessionToken),
+		Host:        "{bucket}.s3.{region}.jdcloud-oss.com",
+	}
+	return lister.GetResource(ctx)
+}
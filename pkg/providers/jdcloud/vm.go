@@ -0,0 +1,86 @@
+package jdcloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type vmProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	list          *schema.Resources
+}
+
+type describeInstancesResult struct {
+	TotalCount int `json:"totalCount"`
+	Instances  []struct {
+		InstanceId              string `json:"instanceId"`
+		InstanceName            string `json:"instanceName"`
+		PrivateIpAddress        string `json:"privateIpAddress"`
+		ElasticIpAddress        string `json:"elasticIpAddress"`
+		PrimaryNetworkInterface struct {
+			NetworkInterface struct {
+				SecondaryIps []struct {
+					PrivateIpAddress string `json:"privateIpAddress"`
+					ElasticIpAddress string `json:"elasticIpAddress"`
+				} `json:"secondaryIps"`
+			} `json:"networkInterface"`
+		} `json:"primaryNetworkInterface"`
+	} `json:"instances"`
+}
+
+func (d *vmProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.describeInstances)
+	return d.list, nil
+}
+
+func (d *vmProvider) describeInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的京东云 VM 资源信息", region)
+		for pageNumber := 1; ; pageNumber++ {
+			var result describeInstancesResult
+			query := url.Values{"pageNumber": {strconv.Itoa(pageNumber)}, "pageSize": {"100"}}
+			err = d.openAPIClient.get(region, "vm", "/v1/regions/"+region+"/instances", query, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 VM 资源: %s", region, err)
+				break
+			}
+			if len(result.Instances) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 VM 资源", region, len(result.Instances))
+			}
+			for _, instance := range result.Instances {
+				var (
+					eips         = []string{instance.ElasticIpAddress}
+					privateIPv4s = []string{instance.PrivateIpAddress}
+				)
+				for _, ip := range instance.PrimaryNetworkInterface.NetworkInterface.SecondaryIps {
+					eips = append(eips, ip.ElasticIpAddress)
+					privateIPv4s = append(privateIPv4s, ip.PrivateIpAddress)
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "VM",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					EIPs:         eips,
+					PrivateIpv4s: privateIPv4s,
+					Public:       instance.ElasticIpAddress != "",
+					Metadata:     map[string]string{"name": instance.InstanceName},
+				})
+			}
+			if pageNumber*100 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
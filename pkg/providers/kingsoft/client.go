@@ -0,0 +1,70 @@
+package kingsoft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// openAPIClient 调用金山云 OpenAPI，签名算法与 AWS Signature Version 4 相同
+type openAPIClient struct {
+	signer     *v4.Signer
+	httpClient *http.Client
+}
+
+type openAPIError struct {
+	Error *struct {
+		Code    string
+		Message string
+	}
+}
+
+func newOpenAPIClient(accessKey, secretKey, sessionToken string) *openAPIClient {
+	return &openAPIClient{
+		signer:     v4.NewSigner(credentials.NewStaticCredentials(accessKey, secretKey, sessionToken)),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// call 调用 {service}.{region}.api.ksyun.com 的 action 接口，并将返回的 JSON 解析到 result 中
+func (c *openAPIClient) call(region, service, version, action string, params url.Values, result interface{}) error {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("Action", action)
+	query.Set("Version", version)
+
+	request, err := http.NewRequest(http.MethodGet, "https://"+service+"."+region+".api.ksyun.com/?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if _, err = c.signer.Sign(request, nil, service, region, time.Now()); err != nil {
+		return err
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		var apiError openAPIError
+		if json.Unmarshal(body, &apiError) == nil && apiError.Error != nil {
+			return fmt.Errorf("%s: %s %s", action, apiError.Error.Code, apiError.Error.Message)
+		}
+		return fmt.Errorf("%s %s: %s", response.Status, action, bytes.TrimSpace(body))
+	}
+	return json.Unmarshal(body, result)
+}
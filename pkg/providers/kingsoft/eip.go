@@ -0,0 +1,75 @@
+package kingsoft
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"sync"
+)
+
+type eipProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	list          *schema.Resources
+}
+
+type describeAddressesResponse struct {
+	NextToken    string
+	AddressesSet []struct {
+		AllocationId string
+		PublicIp     string
+		State        string
+		InstanceId   string
+		InstanceType string
+	}
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.describeAddresses)
+	return d.list, nil
+}
+
+func (d *eipProvider) describeAddresses(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的金山云 EIP 资源信息", region)
+		params := url.Values{"MaxResults": {"1000"}}
+		for {
+			var response describeAddressesResponse
+			err = d.openAPIClient.call(region, "eip", "2016-03-04", "DescribeAddresses", params, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 EIP 资源: %s", region, err)
+				break
+			}
+			if len(response.AddressesSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(response.AddressesSet))
+			}
+			for _, address := range response.AddressesSet {
+				metadata := map[string]string{"status": address.State}
+				if address.InstanceId != "" {
+					metadata["instance_id"] = address.InstanceId
+					metadata["instance_type"] = address.InstanceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     region,
+					ResourceID: address.AllocationId,
+					PublicIPv4: address.PublicIp,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if response.NextToken == "" {
+				break
+			}
+			params.Set("NextToken", response.NextToken)
+		}
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package kingsoft
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type kecProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	list          *schema.Resources
+}
+
+type describeInstancesResponse struct {
+	InstanceCount int
+	InstancesSet  []struct {
+		InstanceId          string
+		InstanceName        string
+		PrivateIpAddress    string
+		NetworkInterfaceSet []struct {
+			PrivateIpAddress string
+			PublicIp         string
+		}
+	}
+}
+
+func (d *kecProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(regions, d.describeInstances)
+	return d.list, nil
+}
+
+func (d *kecProvider) describeInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的金山云 KEC 资源信息", region)
+		// Marker 为记录的偏移量
+		for marker := 0; ; marker += 1000 {
+			var response describeInstancesResponse
+			params := url.Values{"MaxResults": {"1000"}, "Marker": {strconv.Itoa(marker)}}
+			err = d.openAPIClient.call(region, "kec", "2016-03-04", "DescribeInstances", params, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 KEC 资源: %s", region, err)
+				break
+			}
+			if len(response.InstancesSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 KEC 资源", region, len(response.InstancesSet))
+			}
+			for _, instance := range response.InstancesSet {
+				var (
+					publicIPv4s  []string
+					privateIPv4s = []string{instance.PrivateIpAddress}
+				)
+				for _, networkInterface := range instance.NetworkInterfaceSet {
+					privateIPv4s = append(privateIPv4s, networkInterface.PrivateIpAddress)
+					publicIPv4s = append(publicIPv4s, networkInterface.PublicIp)
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "KEC",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					Metadata:     map[string]string{"name": instance.InstanceName},
+				})
+			}
+			if marker+1000 >= response.InstanceCount {
+				break
+			}
+		}
+	}
+	return err
+}
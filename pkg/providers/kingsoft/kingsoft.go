@@ -0,0 +1,91 @@
+package kingsoft
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id            string
+	provider      string
+	config        providerConfig
+	openAPIClient *openAPIClient
+}
+
+type providerConfig struct {
+	accessKeyID     string
+	accessKeySecret string
+	sessionToken    string
+}
+
+// regions 是金山云的公有云区域
+var regions = []string{"cn-beijing-6", "cn-shanghai-2", "cn-guangzhou-1", "cn-hongkong-2", "ap-singapore-1", "eu-east-1"}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	accessKeySecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	sessionToken, okST := options.GetMetadata(utils.SessionToken)
+
+	if okST {
+		gologger.Debug().Msg("找到金山云临时访问凭证")
+	} else {
+		gologger.Debug().Msg("找到金山云永久访问凭证")
+	}
+
+	config := providerConfig{
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		sessionToken:    sessionToken,
+	}
+	openAPIClient := newOpenAPIClient(accessKeyID, accessKeySecret, sessionToken)
+	return &Provider{id: id, provider: utils.Kingsoft, config: config, openAPIClient: openAPIClient}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	kecProvider := &kecProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient}
+	kecList, err := kecProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条金山云 KEC 信息", len(kecList.GetItems()))
+
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条金山云 EIP 信息", len(eipList.GetItems()))
+
+	ks3Provider := &ks3Provider{id: p.id, provider: p.provider, config: p.config}
+	ks3List, err := ks3Provider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条金山云 KS3 信息", len(ks3List.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(kecList)
+	finalList.Merge(eipList)
+	finalList.Merge(ks3List)
+	finalList.AddSummary("KEC", len(kecList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("KS3", len(ks3List.GetItems()))
+	return finalList, nil
+}
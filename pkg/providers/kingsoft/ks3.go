@@ -0,0 +1,44 @@
+package kingsoft
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/s3"
+	"github.com/wgpsec/lc/pkg/schema"
+)
+
+type ks3Provider struct {
+	id       string
+	provider string
+	config   providerConfig
+}
+
+// ks3Regions 是存储桶 Location 与 KS3 域名中区域名称的对应关系
+var ks3Regions = map[string]string{
+	"BEIJING":     "BEIJING",
+	"SHANGHAI":    "cn-shanghai",
+	"GUANGZHOU":   "cn-guangzhou",
+	"HONGKONG":    "cn-hk-1",
+	"RUSSIA":      "rus",
+	"SINGAPORE":   "sgp",
+	"JR_BEIJING":  "jr-beijing",
+	"JR_SHANGHAI": "jr-shanghai",
+	"GOV_BEIJING": "gov-beijing",
+}
+
+// GetResource 通过 KS3 的 S3 兼容接口获取存储桶
+func (d *ks3Provider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	gologger.Debug().Msg("正在获取金山云 KS3 资源信息")
+	lister := &s3.BucketLister{
+		ID:          d.id,
+		Provider:    d.provider,
+		Service:     "KS3",
+		Endpoint:    "https://ks3-cn-beijing.ksyuncs.com",
+		Region:      "BEIJING",
+		Credentials: credentials.NewStaticCredentials(d.config.accessKeyID, d.config.accessKeySecret, d.config.sessionToken),
+		Host:        "{bucket}.ks3-{region}.ksyuncs.com",
+		Regions:     ks3Regions,
+	}
+	return lister.GetResource(ctx)
+}
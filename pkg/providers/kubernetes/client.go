@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// apiClient 直接调用 Kubernetes API Server 的 REST 接口
+type apiClient struct {
+	context    string
+	cluster    string
+	server     string
+	token      string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// listMeta 是 List 接口返回的分页信息
+type listMeta struct {
+	Continue string `json:"continue"`
+}
+
+type objectMeta struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+}
+
+type loadBalancerStatus struct {
+	Ingress []struct {
+		IP       string `json:"ip"`
+		Hostname string `json:"hostname"`
+	} `json:"ingress"`
+}
+
+type apiStatus struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// get 请求 path 并将返回的 JSON 解析到 result 中
+func (c *apiClient) get(path string, query url.Values, result interface{}) error {
+	requestURL := strings.TrimSuffix(c.server, "/") + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(c.token))
+	} else if c.username != "" {
+		request.SetBasicAuth(c.username, c.password)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		var status apiStatus
+		if json.Unmarshal(body, &status) == nil && status.Kind == "Status" {
+			return fmt.Errorf("%s %s: %s", response.Status, path, status.Message)
+		}
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(body))
+	}
+	return json.Unmarshal(body, result)
+}
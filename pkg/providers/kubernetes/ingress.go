@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strings"
+)
+
+type ingressProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+}
+
+type listIngressesResponse struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Rules []struct {
+				Host string `json:"host"`
+			} `json:"rules"`
+			TLS []struct {
+				Hosts []string `json:"hosts"`
+			} `json:"tls"`
+		} `json:"spec"`
+		Status struct {
+			LoadBalancer loadBalancerStatus `json:"loadBalancer"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func (d *ingressProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msgf("正在获取 %s 集群的 Kubernetes Ingress 资源信息", d.apiClient.cluster)
+	query := url.Values{"limit": {"500"}}
+	for {
+		var response listIngressesResponse
+		if err := d.apiClient.get("/apis/networking.k8s.io/v1/ingresses", query, &response); err != nil {
+			return nil, err
+		}
+		for _, ingress := range response.Items {
+			var (
+				hosts     []string
+				addresses []string
+			)
+			for _, rule := range ingress.Spec.Rules {
+				hosts = append(hosts, rule.Host)
+			}
+			for _, tls := range ingress.Spec.TLS {
+				hosts = append(hosts, tls.Hosts...)
+			}
+			for _, item := range ingress.Status.LoadBalancer.Ingress {
+				addresses = append(addresses, item.IP, item.Hostname)
+			}
+			metadata := map[string]string{
+				"cluster":   d.apiClient.cluster,
+				"namespace": ingress.Metadata.Namespace,
+				"name":      ingress.Metadata.Name,
+				"address":   strings.Join(removeEmpty(addresses), ", "),
+			}
+			for _, host := range hosts {
+				// 通配符域名无法直接访问，去掉 *. 后记录上级域名
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "Ingress",
+					ResourceID: ingress.Metadata.UID,
+					DNSName:    strings.TrimPrefix(host, "*."),
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			// status 中的地址可能是 IP，也可能是云服务商负载均衡的域名，Append 时会自动识别类型
+			list.Append(&schema.Resource{
+				ID:          d.id,
+				Provider:    d.provider,
+				Service:     "Ingress",
+				ResourceID:  ingress.Metadata.UID,
+				PublicIPv4s: addresses,
+				Public:      true,
+				Metadata:    metadata,
+			})
+		}
+		if response.Metadata.Continue == "" {
+			break
+		}
+		query.Set("continue", response.Metadata.Continue)
+	}
+	return list, nil
+}
+
+func removeEmpty(items []string) []string {
+	var result []string
+	for _, item := range items {
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// kubeconfig 是 kubeconfig 文件中用到的字段
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+			TLSServerName            string `yaml:"tls-server-name"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string      `yaml:"token"`
+			TokenFile             string      `yaml:"tokenFile"`
+			ClientCertificate     string      `yaml:"client-certificate"`
+			ClientCertificateData string      `yaml:"client-certificate-data"`
+			ClientKey             string      `yaml:"client-key"`
+			ClientKeyData         string      `yaml:"client-key-data"`
+			Username              string      `yaml:"username"`
+			Password              string      `yaml:"password"`
+			Exec                  interface{} `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// loadKubeconfig 读取 kubeconfig 文件，并根据 contextName 创建访问 API Server 的客户端，contextName 为空时使用 current-context
+func loadKubeconfig(path, contextName string) (*apiClient, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config kubeconfig
+	if err = yaml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	if contextName == "" {
+		contextName = config.CurrentContext
+	}
+	// kubeconfig 中的相对路径是相对于 kubeconfig 文件所在的目录
+	baseDir := filepath.Dir(path)
+
+	var clusterName, userName string
+	found := false
+	for _, item := range config.Contexts {
+		if item.Name == contextName {
+			clusterName, userName, found = item.Context.Cluster, item.Context.User, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("kubeconfig 中不存在上下文 %s", contextName)
+	}
+
+	client := &apiClient{context: contextName, cluster: clusterName}
+	tlsConfig := &tls.Config{}
+	found = false
+	for _, item := range config.Clusters {
+		if item.Name != clusterName {
+			continue
+		}
+		found = true
+		client.server = item.Cluster.Server
+		tlsConfig.InsecureSkipVerify = item.Cluster.InsecureSkipTLSVerify
+		tlsConfig.ServerName = item.Cluster.TLSServerName
+		caData, err := readData(item.Cluster.CertificateAuthorityData, item.Cluster.CertificateAuthority, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		if len(caData) > 0 {
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM(caData) {
+				return nil, errors.New("无法解析集群的 CA 证书")
+			}
+			tlsConfig.RootCAs = certPool
+		}
+		break
+	}
+	if !found {
+		return nil, fmt.Errorf("kubeconfig 中不存在集群 %s", clusterName)
+	}
+
+	for _, item := range config.Users {
+		if item.Name != userName {
+			continue
+		}
+		if item.User.Exec != nil {
+			return nil, fmt.Errorf("不支持用户 %s 使用的 exec 认证插件，请使用 token 或者客户端证书", userName)
+		}
+		client.token = item.User.Token
+		if client.token == "" && item.User.TokenFile != "" {
+			token, err := os.ReadFile(resolvePath(item.User.TokenFile, baseDir))
+			if err != nil {
+				return nil, err
+			}
+			client.token = string(token)
+		}
+		client.username, client.password = item.User.Username, item.User.Password
+		certData, err := readData(item.User.ClientCertificateData, item.User.ClientCertificate, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		keyData, err := readData(item.User.ClientKeyData, item.User.ClientKey, baseDir)
+		if err != nil {
+			return nil, err
+		}
+		if len(certData) > 0 && len(keyData) > 0 {
+			certificate, err := tls.X509KeyPair(certData, keyData)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+		break
+	}
+
+	client.httpClient = &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+	}
+	return client, nil
+}
+
+// readData 优先使用 base64 编码的 data 字段，否则读取 file 指向的文件
+func readData(data, file, baseDir string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if file != "" {
+		return os.ReadFile(resolvePath(file, baseDir))
+	}
+	return nil, nil
+}
+
+func resolvePath(path, baseDir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
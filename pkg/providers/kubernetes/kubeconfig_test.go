@@ -0,0 +1,179 @@
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeFile 在 dir 下写入文件，并返回文件的完整路径
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const twoContextsKubeconfig = `
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://10.0.0.1:6443
+- name: prod-cluster
+  cluster:
+    server: https://k8s.example.com
+    insecure-skip-tls-verify: true
+users:
+- name: dev-user
+  user:
+    token: dev-token
+- name: prod-user
+  user:
+    username: admin
+    password: secret
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: prod-user
+`
+
+func TestLoadKubeconfigSelectsContext(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "config", twoContextsKubeconfig)
+
+	client, err := loadKubeconfig(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.context != "dev" || client.cluster != "dev-cluster" || client.server != "https://10.0.0.1:6443" || client.token != "dev-token" {
+		t.Errorf("未指定上下文时应该使用 current-context: %+v", client)
+	}
+
+	client, err = loadKubeconfig(path, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.cluster != "prod-cluster" || client.server != "https://k8s.example.com" || client.username != "admin" || client.password != "secret" {
+		t.Errorf("没有使用指定的上下文: %+v", client)
+	}
+	if !client.httpClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("没有读取 insecure-skip-tls-verify")
+	}
+
+	if _, err = loadKubeconfig(path, "staging"); err == nil || !strings.Contains(err.Error(), "staging") {
+		t.Errorf("不存在的上下文应该返回错误: %v", err)
+	}
+}
+
+func TestLoadKubeconfigRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	certPEM, keyPEM := newCertificate(t)
+	writeFile(t, dir, "pki/ca.crt", certPEM)
+	writeFile(t, dir, "pki/client.crt", certPEM)
+	writeFile(t, dir, "pki/client.key", keyPEM)
+	writeFile(t, dir, "token", "file-token\n")
+	path := writeFile(t, dir, "kube/config", `
+current-context: local
+clusters:
+- name: local
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority: ../pki/ca.crt
+users:
+- name: local
+  user:
+    tokenFile: ../token
+    client-certificate: ../pki/client.crt
+    client-key: ../pki/client.key
+contexts:
+- name: local
+  context:
+    cluster: local
+    user: local
+`)
+	// 相对路径应该相对于 kubeconfig 所在的目录，而不是当前工作目录
+	client, err := loadKubeconfig(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig := client.httpClient.Transport.(*http.Transport).TLSClientConfig
+	if tlsConfig.RootCAs == nil || len(tlsConfig.Certificates) != 1 {
+		t.Errorf("没有读取相对路径的证书: %+v", tlsConfig)
+	}
+	if strings.TrimSpace(client.token) != "file-token" {
+		t.Errorf("没有读取相对路径的 tokenFile: %q", client.token)
+	}
+}
+
+func TestLoadKubeconfigRejectsExec(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "config", `
+current-context: eks
+clusters:
+- name: eks
+  cluster:
+    server: https://eks.example.com
+users:
+- name: eks
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: aws
+      args: ["eks", "get-token", "--cluster-name", "eks"]
+contexts:
+- name: eks
+  context:
+    cluster: eks
+    user: eks
+`)
+	if _, err := loadKubeconfig(path, ""); err == nil || !strings.Contains(err.Error(), "exec") {
+		t.Errorf("使用 exec 认证插件时应该返回错误: %v", err)
+	}
+}
+
+// newCertificate 生成自签名证书，返回 PEM 格式的证书和私钥
+func newCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "lc-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
@@ -0,0 +1,106 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+type Provider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	id, _ := options.GetMetadata(utils.Id)
+
+	// 未配置 kubeconfig 时与 kubectl 一样使用 KUBECONFIG 环境变量或者 ~/.kube/config
+	path, ok := options.GetMetadata(utils.Kubeconfig)
+	if !ok {
+		path = os.Getenv("KUBECONFIG")
+	}
+	if path == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, &utils.ErrNoSuchKey{Name: utils.Kubeconfig}
+		}
+		path = filepath.Join(homeDir, ".kube", "config")
+	}
+	contextName, _ := options.GetMetadata(utils.Context)
+	apiClient, err := loadKubeconfig(path, contextName)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Debug().Msgf("找到 Kubernetes 集群 %s 的访问凭证", apiClient.cluster)
+
+	return &Provider{id: id, provider: utils.Kubernetes, apiClient: apiClient}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	apiServerList := p.getAPIServerResource()
+	gologger.Info().Msgf("获取到 %d 条 Kubernetes API Server 信息", len(apiServerList.GetItems()))
+
+	serviceProvider := &serviceProvider{id: p.id, provider: p.provider, apiClient: p.apiClient}
+	serviceList, err := serviceProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Kubernetes LoadBalancer 信息", len(serviceList.GetItems()))
+
+	ingressProvider := &ingressProvider{id: p.id, provider: p.provider, apiClient: p.apiClient}
+	ingressList, err := ingressProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Kubernetes Ingress 信息", len(ingressList.GetItems()))
+
+	nodeProvider := &nodeProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, nodePorts: serviceProvider.nodePorts}
+	nodeList, err := nodeProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 Kubernetes NodePort 信息", len(nodeList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(apiServerList)
+	finalList.Merge(serviceList)
+	finalList.Merge(ingressList)
+	finalList.Merge(nodeList)
+	finalList.AddSummary("APIServer", len(apiServerList.GetItems()))
+	finalList.AddSummary("LoadBalancer", len(serviceList.GetItems()))
+	finalList.AddSummary("Ingress", len(ingressList.GetItems()))
+	finalList.AddSummary("NodePort", len(nodeList.GetItems()))
+	return finalList, nil
+}
+
+// getAPIServerResource 返回 kubeconfig 中 API Server 的地址
+func (p *Provider) getAPIServerResource() *schema.Resources {
+	var list = schema.NewResources()
+	server, err := url.Parse(p.apiClient.server)
+	if err != nil {
+		gologger.Debug().Msgf("无法解析 API Server 地址 %s: %s", p.apiClient.server, err)
+		return list
+	}
+	list.Append(&schema.Resource{
+		ID:         p.id,
+		Provider:   p.provider,
+		Service:    "APIServer",
+		ResourceID: p.apiClient.cluster,
+		PublicIPv4: server.Hostname(),
+		Public:     true,
+		Metadata:   map[string]string{"context": p.apiClient.context, "server": p.apiClient.server},
+	})
+	return list
+}
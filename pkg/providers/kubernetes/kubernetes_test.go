@@ -0,0 +1,131 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// apiServerPages 的 key 为请求路径和 continue 参数，value 为返回的 List
+var apiServerPages = map[string]string{
+	"/api/v1/services": `{"metadata": {"continue": "services-2"}, "items": [
+		{"metadata": {"name": "web", "namespace": "default", "uid": "svc-web"},
+		 "spec": {"type": "LoadBalancer", "ports": [{"protocol": "TCP", "port": 80, "nodePort": 30080}]},
+		 "status": {"loadBalancer": {"ingress": [{"ip": "34.1.1.1"}, {"hostname": "a1.elb.amazonaws.com"}]}}},
+		{"metadata": {"name": "internal", "namespace": "default", "uid": "svc-internal"},
+		 "spec": {"type": "ClusterIP", "ports": [{"protocol": "TCP", "port": 8080}]}}
+	]}`,
+	"/api/v1/services?continue=services-2": `{"metadata": {}, "items": [
+		{"metadata": {"name": "admin", "namespace": "ops", "uid": "svc-admin"},
+		 "spec": {"type": "NodePort", "ports": [{"protocol": "TCP", "port": 8443, "nodePort": 30443}]}}
+	]}`,
+	"/apis/networking.k8s.io/v1/ingresses": `{"metadata": {"continue": "ingresses-2"}, "items": [
+		{"metadata": {"name": "www", "namespace": "default", "uid": "ing-www"},
+		 "spec": {"rules": [{"host": "www.example.com"}], "tls": [{"hosts": ["*.example.com"]}]},
+		 "status": {"loadBalancer": {"ingress": [{"ip": "34.2.2.2"}]}}}
+	]}`,
+	"/apis/networking.k8s.io/v1/ingresses?continue=ingresses-2": `{"metadata": {}, "items": [
+		{"metadata": {"name": "api", "namespace": "default", "uid": "ing-api"},
+		 "spec": {"rules": [{"host": "api.example.org"}]}}
+	]}`,
+	"/api/v1/nodes": `{"metadata": {"continue": "nodes-2"}, "items": [
+		{"metadata": {"name": "node-1", "uid": "node-1"},
+		 "status": {"addresses": [{"type": "InternalIP", "address": "10.0.0.5"}, {"type": "ExternalIP", "address": "34.3.3.3"}]}}
+	]}`,
+	"/api/v1/nodes?continue=nodes-2": `{"metadata": {}, "items": [
+		{"metadata": {"name": "node-2", "uid": "node-2"},
+		 "status": {"addresses": [{"type": "InternalIP", "address": "10.0.0.6"}]}}
+	]}`,
+}
+
+func TestResourcesFromAPIServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"kind": "Status", "message": "Unauthorized"}`)
+			return
+		}
+		if r.URL.Query().Get("limit") != "500" {
+			t.Errorf("List 请求没有设置 limit: %s", r.URL)
+		}
+		key := r.URL.Path
+		if next := r.URL.Query().Get("continue"); next != "" {
+			key += "?continue=" + next
+		}
+		page, ok := apiServerPages[key]
+		if !ok {
+			t.Errorf("未预期的请求: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, page)
+	}))
+	defer server.Close()
+
+	// 使用 certificate-authority-data 信任 httptest 的证书
+	caData := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	path := writeFile(t, t.TempDir(), "config", fmt.Sprintf(`
+current-context: test
+clusters:
+- name: test-cluster
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+users:
+- name: test-user
+  user:
+    token: test-token
+contexts:
+- name: test
+  context:
+    cluster: test-cluster
+    user: test-user
+`, server.URL, caData))
+
+	provider, err := New(schema.OptionBlock{utils.Kubeconfig: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := provider.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		items[item.DNSName+item.PublicIPv4+item.PrivateIpv4] = item
+	}
+	expected := map[string]string{
+		"34.1.1.1":             "LoadBalancer",
+		"a1.elb.amazonaws.com": "LoadBalancer",
+		"www.example.com":      "Ingress",
+		"example.com":          "Ingress",
+		"34.2.2.2":             "Ingress",
+		"api.example.org":      "Ingress",
+		"34.3.3.3":             "NodePort",
+	}
+	for address, service := range expected {
+		if item := items[address]; item == nil || item.Service != service || item.Metadata["cluster"] != "test-cluster" {
+			t.Errorf("缺少 %s 的 %s 资产: %+v", address, service, item)
+		}
+	}
+	if items["10.0.0.5"] != nil || items["10.0.0.6"] != nil {
+		t.Errorf("节点的内网地址不应该输出")
+	}
+	// 第二页中 NodePort 类型 Service 开放的端口也需要记录到节点上
+	if node := items["34.3.3.3"]; node != nil && node.Metadata["node_ports"] != "30080/TCP, 30443/TCP" {
+		t.Errorf("节点开放的端口不正确: %s", node.Metadata["node_ports"])
+	}
+	if web := items["34.1.1.1"]; web != nil && (web.Metadata["ports"] != "80/TCP" || web.ResourceID != "svc-web") {
+		t.Errorf("LoadBalancer 的信息不正确: %+v", web)
+	}
+	if len(list.GetItems()) != len(expected)+1 {
+		t.Errorf("除了 API Server 以外应该获取到 %d 条资产，实际为 %d 条", len(expected), len(list.GetItems())-1)
+	}
+}
@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/url"
+	"strings"
+)
+
+type nodeProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	nodePorts []string
+}
+
+type listNodesResponse struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []struct {
+		Metadata objectMeta `json:"metadata"`
+		Status   struct {
+			Addresses []struct {
+				Type    string `json:"type"`
+				Address string `json:"address"`
+			} `json:"addresses"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func (d *nodeProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	// 没有 NodePort 时节点上不会开放 Service 端口，不需要获取节点
+	if len(d.nodePorts) == 0 {
+		return list, nil
+	}
+	gologger.Debug().Msgf("正在获取 %s 集群的 Kubernetes Node 资源信息", d.apiClient.cluster)
+	nodePorts := strings.Join(utils.RemoveRepeatedElement(d.nodePorts), ", ")
+	query := url.Values{"limit": {"500"}}
+	for {
+		var response listNodesResponse
+		if err := d.apiClient.get("/api/v1/nodes", query, &response); err != nil {
+			return nil, err
+		}
+		for _, node := range response.Items {
+			var externalIPs []string
+			for _, address := range node.Status.Addresses {
+				if address.Type == "ExternalIP" {
+					externalIPs = append(externalIPs, address.Address)
+				}
+			}
+			if len(externalIPs) == 0 {
+				continue
+			}
+			list.Append(&schema.Resource{
+				ID:          d.id,
+				Provider:    d.provider,
+				Service:     "NodePort",
+				ResourceID:  node.Metadata.UID,
+				PublicIPv4s: externalIPs,
+				Public:      true,
+				Metadata: map[string]string{
+					"cluster":    d.apiClient.cluster,
+					"name":       node.Metadata.Name,
+					"node_ports": nodePorts,
+				},
+			})
+		}
+		if response.Metadata.Continue == "" {
+			break
+		}
+		query.Set("continue", response.Metadata.Continue)
+	}
+	return list, nil
+}
@@ -0,0 +1,104 @@
+package kubernetes
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type serviceProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+
+	// nodePorts 记录所有 NodePort 和 LoadBalancer 类型 Service 在节点上开放的端口
+	nodePorts []string
+}
+
+type listServicesResponse struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Type        string   `json:"type"`
+			ExternalIPs []string `json:"externalIPs"`
+			Ports       []struct {
+				Protocol string `json:"protocol"`
+				Port     int    `json:"port"`
+				NodePort int    `json:"nodePort"`
+			} `json:"ports"`
+		} `json:"spec"`
+		Status struct {
+			LoadBalancer loadBalancerStatus `json:"loadBalancer"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func (d *serviceProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msgf("正在获取 %s 集群的 Kubernetes Service 资源信息", d.apiClient.cluster)
+	query := url.Values{"limit": {"500"}}
+	for {
+		var response listServicesResponse
+		if err := d.apiClient.get("/api/v1/services", query, &response); err != nil {
+			return nil, err
+		}
+		for _, service := range response.Items {
+			if service.Spec.Type != "LoadBalancer" && service.Spec.Type != "NodePort" && len(service.Spec.ExternalIPs) == 0 {
+				continue
+			}
+			var ports []string
+			for _, port := range service.Spec.Ports {
+				ports = append(ports, strconv.Itoa(port.Port)+"/"+port.Protocol)
+				if port.NodePort != 0 {
+					d.nodePorts = append(d.nodePorts, strconv.Itoa(port.NodePort)+"/"+port.Protocol)
+				}
+			}
+			var (
+				ips       []string
+				hostnames []string
+			)
+			ips = append(ips, service.Spec.ExternalIPs...)
+			for _, ingress := range service.Status.LoadBalancer.Ingress {
+				ips = append(ips, ingress.IP)
+				hostnames = append(hostnames, ingress.Hostname)
+			}
+			metadata := map[string]string{
+				"cluster":   d.apiClient.cluster,
+				"namespace": service.Metadata.Namespace,
+				"name":      service.Metadata.Name,
+				"type":      service.Spec.Type,
+				"ports":     strings.Join(ports, ", "),
+			}
+			// 负载均衡的地址可能是 IP，也可能是 AWS ELB 等云服务商提供的域名
+			list.Append(&schema.Resource{
+				ID:          d.id,
+				Provider:    d.provider,
+				Service:     "LoadBalancer",
+				ResourceID:  service.Metadata.UID,
+				PublicIPv4s: ips,
+				Public:      true,
+				Metadata:    metadata,
+			})
+			for _, hostname := range hostnames {
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "LoadBalancer",
+					ResourceID: service.Metadata.UID,
+					DNSName:    hostname,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+		}
+		if response.Metadata.Continue == "" {
+			break
+		}
+		query.Set("continue", response.Metadata.Continue)
+	}
+	return list, nil
+}
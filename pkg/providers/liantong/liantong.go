@@ -3,14 +3,18 @@ package liantong
 import (
 	"context"
 	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/apig"
 	"github.com/wgpsec/lc/pkg/schema"
 	"github.com/wgpsec/lc/utils"
 )
 
 type Provider struct {
-	id       string
-	provider string
-	config   providerConfig
+	id        string
+	provider  string
+	config    providerConfig
+	endpoint  string
+	apiClient *apig.Client
+	projects  []apig.Project
 }
 
 type providerConfig struct {
@@ -36,12 +40,26 @@ func New(options schema.OptionBlock) (*Provider, error) {
 		gologger.Debug().Msg("找到联通云永久访问凭证")
 	}
 
+	// 联通云 OpenAPI 的域名后缀，服务地址的格式为 {service}.{region}.{endpoint}
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "cucloud.cn"
+	}
+
 	config := providerConfig{
 		accessKeyID:     accessKeyID,
 		accessKeySecret: accessKeySecret,
 		sessionToken:    sessionToken,
 	}
-	return &Provider{id: id, provider: utils.LianTong, config: config}, nil
+
+	// projects
+	apiClient := apig.NewClient(accessKeyID, accessKeySecret, sessionToken)
+	projects, err := apig.ListProjects(apiClient, "iam."+endpoint)
+	if err != nil {
+		// 没有 IAM 权限时仍然可以获取 OSS 资源
+		gologger.Debug().Msgf("无法获取联通云项目列表: %s", err)
+	}
+	return &Provider{id: id, provider: utils.LianTong, config: config, endpoint: endpoint, apiClient: apiClient, projects: projects}, nil
 }
 
 func (p *Provider) Name() string {
@@ -59,7 +77,27 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条联通云 OSS 信息", len(buckets.GetItems()))
+
+	ecsProvider := &apig.ECSProvider{ID: p.id, Provider: p.provider, Name: "联通云", Endpoint: p.endpoint, Client: p.apiClient, Projects: p.projects}
+	ecsList, err := ecsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条联通云 ECS 信息", len(ecsList.GetItems()))
+
+	eipProvider := &apig.EIPProvider{ID: p.id, Provider: p.provider, Name: "联通云", Endpoint: p.endpoint, Client: p.apiClient, Projects: p.projects}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条联通云 EIP 信息", len(eipList.GetItems()))
+
 	finalList := schema.NewResources()
 	finalList.Merge(buckets)
+	finalList.Merge(ecsList)
+	finalList.Merge(eipList)
+	finalList.AddSummary("OSS", len(buckets.GetItems()))
+	finalList.AddSummary("ECS", len(ecsList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
 	return finalList, nil
 }
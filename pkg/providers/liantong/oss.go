@@ -25,12 +25,6 @@ type regions struct {
 var list = schema.NewResources()
 
 func (d *ossProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		err     error
-		wg      sync.WaitGroup
-	)
-
 	zones := []regions{
 		{region: "cn-langfang-2", endpoint: "obs-helf.cucloud.cn"},
 		{region: "cn-xiamen-1", endpoint: "obs-fjxm.cucloud.cn"},
@@ -47,25 +41,8 @@ func (d *ossProvider) GetResource(ctx context.Context) (*schema.Resources, error
 		//{region: "cn-shijiazhuang-1", endpoint: "obs-hesjz.cucloud.cn"},
 		{region: "cn-changsha-1", endpoint: "obs-hncs.cucloud.cn"},
 	}
-	threads = schema.GetThreads()
-
-	taskCh := make(chan regions, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.listBuckets(taskCh, &wg)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range zones {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
+	schema.RunWorkers(zones, d.listBuckets)
 	return list, nil
-
 }
 
 func (d *ossProvider) listBuckets(ch <-chan regions, wg *sync.WaitGroup) error {
@@ -92,10 +69,13 @@ func (d *ossProvider) listBuckets(ch <-chan regions, wg *sync.WaitGroup) error {
 			endpointBuilder.WriteString(aws.StringValue(bucket.Name))
 			endpointBuilder.WriteString("." + region.endpoint)
 			list.Append(&schema.Resource{
-				ID:       d.id,
-				Public:   true,
-				DNSName:  endpointBuilder.String(),
-				Provider: d.provider,
+				ID:         d.id,
+				Public:     true,
+				DNSName:    endpointBuilder.String(),
+				Provider:   d.provider,
+				Service:    "OSS",
+				Region:     region.region,
+				ResourceID: aws.StringValue(bucket.Name),
 			})
 		}
 	}
@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// keystoneClient 使用 Keystone v3 的用户名密码认证，并根据服务目录调用各个服务的 API
+type keystoneClient struct {
+	token      string
+	catalog    []catalogEntry
+	httpClient *http.Client
+}
+
+type catalogEntry struct {
+	Type      string `json:"type"`
+	Endpoints []struct {
+		Interface string `json:"interface"`
+		Region    string `json:"region"`
+		RegionID  string `json:"region_id"`
+		URL       string `json:"url"`
+	} `json:"endpoints"`
+}
+
+type tokenResponse struct {
+	Token struct {
+		Catalog []catalogEntry `json:"catalog"`
+	} `json:"token"`
+}
+
+// endpoint 是某个区域下服务的公网地址
+type endpoint struct {
+	region string
+	url    string
+}
+
+func newKeystoneClient(authURL, username, password, project, domain string) (*keystoneClient, error) {
+	authURL = strings.TrimSuffix(authURL, "/")
+	if !strings.HasSuffix(authURL, "/v3") {
+		authURL += "/v3"
+	}
+	domainScope := map[string]string{"name": domain}
+	body, _ := json.Marshal(map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{"name": username, "domain": domainScope, "password": password},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{"name": project, "domain": domainScope},
+			},
+		},
+	})
+
+	client := &keystoneClient{httpClient: &http.Client{Timeout: 30 * time.Second}}
+	response, err := client.httpClient.Post(authURL+"/auth/tokens", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("Keystone 认证失败 %s: %s", response.Status, bytes.TrimSpace(data))
+	}
+	var result tokenResponse
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	client.token = response.Header.Get("X-Subject-Token")
+	if client.token == "" {
+		return nil, errors.New("Keystone 返回的结果中没有 X-Subject-Token")
+	}
+	client.catalog = result.Token.Catalog
+	return client, nil
+}
+
+// endpoints 返回服务目录中 serviceType 类型服务的所有公网地址，region 不为空时只返回该区域的地址
+func (c *keystoneClient) endpoints(serviceType, region string) []endpoint {
+	var endpoints []endpoint
+	for _, entry := range c.catalog {
+		if entry.Type != serviceType {
+			continue
+		}
+		for _, item := range entry.Endpoints {
+			itemRegion := item.RegionID
+			if itemRegion == "" {
+				itemRegion = item.Region
+			}
+			if item.Interface != "public" || (region != "" && itemRegion != region) {
+				continue
+			}
+			endpoints = append(endpoints, endpoint{region: itemRegion, url: strings.TrimSuffix(item.URL, "/")})
+		}
+	}
+	return endpoints
+}
+
+// get 请求 requestURL 并将返回的 JSON 解析到 result 中，返回值为响应头
+func (c *keystoneClient) get(requestURL string, result interface{}) (http.Header, error) {
+	return c.do(http.MethodGet, requestURL, result)
+}
+
+// head 只返回 requestURL 的响应头，例如 Swift 容器的元数据
+func (c *keystoneClient) head(requestURL string) (http.Header, error) {
+	return c.do(http.MethodHead, requestURL, nil)
+}
+
+func (c *keystoneClient) do(method, requestURL string, result interface{}) (http.Header, error) {
+	request, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("X-Auth-Token", c.token)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("%s %s: %s", response.Status, requestURL, bytes.TrimSpace(body))
+	}
+	if response.StatusCode == http.StatusNoContent || result == nil {
+		return response.Header, nil
+	}
+	return response.Header, json.Unmarshal(body, result)
+}
+
+// withVersion 在服务地址后补充 API 版本，部分部署的服务目录中已经包含了版本
+func withVersion(serviceURL, version string) string {
+	if strings.HasSuffix(serviceURL, "/"+version) {
+		return serviceURL
+	}
+	return serviceURL + "/" + version
+}
@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type floatingIPProvider struct {
+	id             string
+	provider       string
+	keystoneClient *keystoneClient
+	region         string
+}
+
+type listFloatingIPsResponse struct {
+	FloatingIPs []struct {
+		ID                string `json:"id"`
+		FloatingIPAddress string `json:"floating_ip_address"`
+		FixedIPAddress    string `json:"fixed_ip_address"`
+		PortID            string `json:"port_id"`
+		Status            string `json:"status"`
+	} `json:"floatingips"`
+}
+
+func (d *floatingIPProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, endpoint := range d.keystoneClient.endpoints("network", d.region) {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 OpenStack 浮动 IP 资源信息", endpoint.region)
+		query := url.Values{"limit": {"1000"}}
+		for {
+			var response listFloatingIPsResponse
+			_, err := d.keystoneClient.get(withVersion(endpoint.url, "v2.0")+"/floatingips?"+query.Encode(), &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的浮动 IP 资源: %s", endpoint.region, err)
+				break
+			}
+			if len(response.FloatingIPs) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条浮动 IP 资源", endpoint.region, len(response.FloatingIPs))
+			}
+			for _, floatingIP := range response.FloatingIPs {
+				metadata := map[string]string{"status": floatingIP.Status}
+				if floatingIP.PortID != "" {
+					metadata["port_id"] = floatingIP.PortID
+					metadata["fixed_ip_address"] = floatingIP.FixedIPAddress
+				}
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "FloatingIP",
+					Region:     endpoint.region,
+					ResourceID: floatingIP.ID,
+					PublicIPv4: floatingIP.FloatingIPAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if len(response.FloatingIPs) < 1000 {
+				break
+			}
+			query.Set("marker", response.FloatingIPs[len(response.FloatingIPs)-1].ID)
+		}
+	}
+	return list, nil
+}
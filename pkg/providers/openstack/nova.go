@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type novaProvider struct {
+	id             string
+	provider       string
+	keystoneClient *keystoneClient
+	region         string
+}
+
+type listServersResponse struct {
+	Servers []struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		Status    string `json:"status"`
+		Addresses map[string][]struct {
+			Addr    string `json:"addr"`
+			Version int    `json:"version"`
+			Type    string `json:"OS-EXT-IPS:type"`
+		} `json:"addresses"`
+	} `json:"servers"`
+}
+
+func (d *novaProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, endpoint := range d.keystoneClient.endpoints("compute", d.region) {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 OpenStack Nova 资源信息", endpoint.region)
+		query := url.Values{"limit": {"1000"}}
+		for {
+			var response listServersResponse
+			_, err := d.keystoneClient.get(endpoint.url+"/servers/detail?"+query.Encode(), &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 Nova 资源: %s", endpoint.region, err)
+				break
+			}
+			if len(response.Servers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 Nova 资源", endpoint.region, len(response.Servers))
+			}
+			for _, server := range response.Servers {
+				var (
+					floatingIPs []string
+					fixedIPv4s  []string
+					ipv6s       []string
+				)
+				// OS-EXT-IPS:type 为 floating 时是绑定的浮动 IP，fixed 为网络中分配的地址
+				for _, addresses := range server.Addresses {
+					for _, address := range addresses {
+						switch {
+						case address.Version == 6:
+							ipv6s = append(ipv6s, address.Addr)
+						case address.Type == "floating":
+							floatingIPs = append(floatingIPs, address.Addr)
+						default:
+							fixedIPv4s = append(fixedIPv4s, address.Addr)
+						}
+					}
+				}
+				// 私有云的 fixed 地址也可能是公网地址，Append 时会根据地址段区分公网和私网
+				list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "Nova",
+					Region:       endpoint.region,
+					ResourceID:   server.ID,
+					EIPs:         floatingIPs,
+					PrivateIpv4s: fixedIPv4s,
+					IPv6s:        ipv6s,
+					Public:       len(floatingIPs) > 0,
+					Metadata:     map[string]string{"name": server.Name, "status": server.Status},
+				})
+			}
+			if len(response.Servers) < 1000 {
+				break
+			}
+			query.Set("marker", response.Servers[len(response.Servers)-1].ID)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,65 @@
+package openstack
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+)
+
+type octaviaProvider struct {
+	id             string
+	provider       string
+	keystoneClient *keystoneClient
+	region         string
+}
+
+type listLoadBalancersResponse struct {
+	LoadBalancers []struct {
+		ID                 string `json:"id"`
+		Name               string `json:"name"`
+		VipAddress         string `json:"vip_address"`
+		VipPortID          string `json:"vip_port_id"`
+		ProvisioningStatus string `json:"provisioning_status"`
+	} `json:"loadbalancers"`
+}
+
+func (d *octaviaProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, endpoint := range d.keystoneClient.endpoints("load-balancer", d.region) {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 OpenStack Octavia 资源信息", endpoint.region)
+		query := url.Values{"limit": {"1000"}}
+		for {
+			var response listLoadBalancersResponse
+			_, err := d.keystoneClient.get(withVersion(endpoint.url, "v2")+"/lbaas/loadbalancers?"+query.Encode(), &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 Octavia 资源: %s", endpoint.region, err)
+				break
+			}
+			if len(response.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 Octavia 资源", endpoint.region, len(response.LoadBalancers))
+			}
+			for _, loadBalancer := range response.LoadBalancers {
+				// VIP 绑定的浮动 IP 可以通过浮动 IP 的 port_id 与 vip_port_id 对应
+				list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "Octavia",
+					Region:      endpoint.region,
+					ResourceID:  loadBalancer.ID,
+					PrivateIpv4: loadBalancer.VipAddress,
+					Metadata: map[string]string{
+						"name":        loadBalancer.Name,
+						"status":      loadBalancer.ProvisioningStatus,
+						"vip_port_id": loadBalancer.VipPortID,
+					},
+				})
+			}
+			if len(response.LoadBalancers) < 1000 {
+				break
+			}
+			query.Set("marker", response.LoadBalancers[len(response.LoadBalancers)-1].ID)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,98 @@
+package openstack
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id             string
+	provider       string
+	keystoneClient *keystoneClient
+	region         string
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	username, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	password, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	authURL, ok := options.GetMetadata(utils.AuthURL)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AuthURL}
+	}
+	project, ok := options.GetMetadata(utils.Project)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.Project}
+	}
+	domain, ok := options.GetMetadata(utils.Domain)
+	if !ok {
+		domain = "Default"
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	// 未配置 region 时获取服务目录中所有区域的资源
+	region, _ := options.GetMetadata(utils.Region)
+
+	gologger.Debug().Msg("找到 OpenStack 用户名密码凭证")
+
+	keystoneClient, err := newKeystoneClient(authURL, username, password, project, domain)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{id: id, provider: utils.OpenStack, keystoneClient: keystoneClient, region: region}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	novaProvider := &novaProvider{id: p.id, provider: p.provider, keystoneClient: p.keystoneClient, region: p.region}
+	novaList, err := novaProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 OpenStack Nova 信息", len(novaList.GetItems()))
+
+	floatingIPProvider := &floatingIPProvider{id: p.id, provider: p.provider, keystoneClient: p.keystoneClient, region: p.region}
+	floatingIPList, err := floatingIPProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 OpenStack 浮动 IP 信息", len(floatingIPList.GetItems()))
+
+	octaviaProvider := &octaviaProvider{id: p.id, provider: p.provider, keystoneClient: p.keystoneClient, region: p.region}
+	octaviaList, err := octaviaProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 OpenStack Octavia 信息", len(octaviaList.GetItems()))
+
+	swiftProvider := &swiftProvider{id: p.id, provider: p.provider, keystoneClient: p.keystoneClient, region: p.region}
+	swiftList, err := swiftProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 OpenStack Swift 信息", len(swiftList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(novaList)
+	finalList.Merge(floatingIPList)
+	finalList.Merge(octaviaList)
+	finalList.Merge(swiftList)
+	finalList.AddSummary("Nova", len(novaList.GetItems()))
+	finalList.AddSummary("FloatingIP", len(floatingIPList.GetItems()))
+	finalList.AddSummary("Octavia", len(octaviaList.GetItems()))
+	finalList.AddSummary("Swift", len(swiftList.GetItems()))
+	return finalList, nil
+}
@@ -0,0 +1,73 @@
+package openstack
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strings"
+)
+
+type swiftProvider struct {
+	id             string
+	provider       string
+	keystoneClient *keystoneClient
+	region         string
+}
+
+type swiftContainer struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (d *swiftProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	for _, endpoint := range d.keystoneClient.endpoints("object-store", d.region) {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 OpenStack Swift 资源信息", endpoint.region)
+		endpointURL, err := url.Parse(endpoint.url)
+		if err != nil {
+			gologger.Debug().Msgf("无法解析 Swift 地址 %s: %s", endpoint.url, err)
+			continue
+		}
+		var containers, publicContainers []string
+		query := url.Values{"format": {"json"}, "limit": {"10000"}}
+		for {
+			var response []swiftContainer
+			if _, err = d.keystoneClient.get(endpoint.url+"?"+query.Encode(), &response); err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 Swift 容器: %s", endpoint.region, err)
+				break
+			}
+			for _, container := range response {
+				containers = append(containers, container.Name)
+				// X-Container-Read 包含 .r:* 时任何人都可以读取容器中的对象
+				header, err := d.keystoneClient.head(endpoint.url + "/" + url.PathEscape(container.Name))
+				if err == nil && strings.Contains(header.Get("X-Container-Read"), ".r:*") {
+					publicContainers = append(publicContainers, container.Name)
+				}
+			}
+			if len(response) < 10000 {
+				break
+			}
+			query.Set("marker", response[len(response)-1].Name)
+		}
+		if len(containers) == 0 {
+			continue
+		}
+		gologger.Warning().Msgf("在 %s 区域下获取到 %d 个 Swift 容器", endpoint.region, len(containers))
+		// 容器通过路径访问，同一个区域的所有容器共用一个地址
+		list.Append(&schema.Resource{
+			ID:         d.id,
+			Provider:   d.provider,
+			Service:    "Swift",
+			Region:     endpoint.region,
+			ResourceID: endpoint.url,
+			DNSName:    endpointURL.Hostname(),
+			Public:     true,
+			Metadata: map[string]string{
+				"containers":        strings.Join(containers, ", "),
+				"public_containers": strings.Join(publicContainers, ", "),
+			},
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSwiftReadsContainerACLWithHead(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v3/auth/tokens" {
+			w.Header().Set("X-Subject-Token", "token-1")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"token": map[string]interface{}{"catalog": []map[string]interface{}{{
+				"type": "object-store",
+				"endpoints": []map[string]string{
+					{"interface": "public", "region": "RegionOne", "url": server.URL + "/v1/AUTH_demo"},
+					{"interface": "internal", "region": "RegionOne", "url": "http://swift.internal:8080/v1/AUTH_demo"},
+				},
+			}}}})
+			return
+		}
+		if r.Header.Get("X-Auth-Token") != "token-1" {
+			t.Errorf("请求没有携带 X-Auth-Token: %s", r.URL)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/AUTH_demo":
+			json.NewEncoder(w).Encode([]swiftContainer{{Name: "website", Count: 3}, {Name: "backup", Count: 10}})
+		case r.Method == http.MethodHead && r.URL.Path == "/v1/AUTH_demo/website":
+			w.Header().Set("X-Container-Read", ".r:*,.rlistings")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodHead && r.URL.Path == "/v1/AUTH_demo/backup":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			// 读取容器的 ACL 不应该列出容器中的对象
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := New(schema.OptionBlock{
+		utils.AccessKey: "admin",
+		utils.SecretKey: "secret",
+		utils.AuthURL:   server.URL,
+		utils.Project:   "demo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	swiftProvider := &swiftProvider{id: "test", provider: utils.OpenStack, keystoneClient: provider.keystoneClient}
+	list, err := swiftProvider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := list.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("同一个区域的容器应该共用一个地址，实际为 %d 条", len(items))
+	}
+	if items[0].Region != "RegionOne" || items[0].Metadata["containers"] != "website, backup" || items[0].Metadata["public_containers"] != "website" {
+		t.Errorf("Swift 资产不正确: %+v", items[0])
+	}
+}
@@ -0,0 +1,99 @@
+package qiniu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/projectdiscovery/gologger"
+	"github.com/qiniu/go-sdk/v7/auth"
+	"github.com/wgpsec/lc/pkg/schema"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// cdnAPIHost 是七牛云 CDN 管理接口的地址
+const cdnAPIHost = "https://api.qiniu.com"
+
+type cdnProvider struct {
+	id         string
+	provider   string
+	kodoClient *auth.Credentials
+	apiHost    string
+}
+
+type listDomainsResponse struct {
+	Marker  string `json:"marker"`
+	Domains []struct {
+		Name              string `json:"name"`
+		Type              string `json:"type"`
+		Cname             string `json:"cname"`
+		Protocol          string `json:"protocol"`
+		OperatingState    string `json:"operatingState"`
+		SourceType        string `json:"sourceType"`
+		SourceQiniuBucket string `json:"sourceQiniuBucket"`
+	} `json:"domains"`
+}
+
+func (d *cdnProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取七牛云 CDN 域名信息")
+	query := url.Values{"limit": {"1000"}}
+	for {
+		var response listDomainsResponse
+		if err := d.listDomains(query, &response); err != nil {
+			gologger.Debug().Msgf("无法获取七牛云 CDN 域名列表: %s", err)
+			break
+		}
+		for _, domain := range response.Domains {
+			metadata := map[string]string{
+				"cname":    domain.Cname,
+				"protocol": domain.Protocol,
+				"state":    domain.OperatingState,
+			}
+			if domain.SourceQiniuBucket != "" {
+				metadata["source_bucket"] = domain.SourceQiniuBucket
+			}
+			list.Append(&schema.Resource{
+				ID:         d.id,
+				Public:     true,
+				DNSName:    domain.Name,
+				Provider:   d.provider,
+				Service:    "CDN",
+				ResourceID: domain.Name,
+				Metadata:   metadata,
+			})
+		}
+		if response.Marker == "" {
+			break
+		}
+		query.Set("marker", response.Marker)
+	}
+	return list, nil
+}
+
+// listDomains 调用 CDN 域名列表接口，使用 Qiniu 管理凭证签名
+func (d *cdnProvider) listDomains(query url.Values, result interface{}) error {
+	request, err := http.NewRequest(http.MethodGet, d.apiHost+"/domain?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if err = d.kodoClient.AddToken(auth.TokenQiniu, request); err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", response.Status, body)
+	}
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,52 @@
+package qiniu
+
+import (
+	"context"
+	"github.com/qiniu/go-sdk/v7/auth"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// 绑定在存储桶上的域名会先被 Kodo 获取到，CDN 列表中仍然需要保留 cname 和 source_bucket
+func TestCdnKeepsDomainsBoundToBuckets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domain" || !strings.HasPrefix(r.Header.Get("Authorization"), "Qiniu ") {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("marker") == "" {
+			w.Write([]byte(`{"marker":"next","domains":[{"name":"img.example.com","cname":"img-example-com-idvb4ai.qiniudns.com","protocol":"https","operatingState":"success","sourceQiniuBucket":"images"}]}`))
+			return
+		}
+		w.Write([]byte(`{"marker":"","domains":[{"name":"static.example.com","cname":"static-example-com.qiniudns.com","protocol":"http","operatingState":"success"}]}`))
+	}))
+	defer server.Close()
+
+	buckets := schema.NewResources()
+	buckets.Append(&schema.Resource{Provider: "qiniu", Service: "Kodo", ResourceID: "images", DNSName: "img.example.com"})
+
+	cdnProvider := &cdnProvider{id: "test", provider: "qiniu", kodoClient: auth.New("ak", "sk"), apiHost: server.URL}
+	domains, err := cdnProvider.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := domains.GetItems()
+	if len(items) != 2 {
+		t.Fatalf("期望获取到 2 个 CDN 域名，实际为 %d 个", len(items))
+	}
+	if items[0].DNSName != "img.example.com" || items[0].Metadata["source_bucket"] != "images" || items[0].Metadata["cname"] == "" {
+		t.Errorf("CDN 域名缺少 Metadata: %+v", items[0])
+	}
+
+	finalList := schema.NewResources()
+	finalList.Merge(buckets)
+	finalList.Merge(domains)
+	for _, item := range finalList.GetItems() {
+		if item.DNSName == "img.example.com" && item.Metadata["cname"] == "" {
+			t.Errorf("合并后丢失了 CDN 的 cname: %+v", item)
+		}
+	}
+}
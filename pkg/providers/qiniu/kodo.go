@@ -14,6 +14,17 @@ type kodoProvider struct {
 	kodoClient *auth.Credentials
 }
 
+// s3Regions 是 Kodo 区域 ID 与 S3 兼容域名中区域名称的对应关系
+var s3Regions = map[string]string{
+	"z0":             "cn-east-1",
+	"cn-east-2":      "cn-east-2",
+	"z1":             "cn-north-1",
+	"z2":             "cn-south-1",
+	"na0":            "us-north-1",
+	"as0":            "ap-southeast-1",
+	"ap-northeast-1": "ap-northeast-1",
+}
+
 func (d *kodoProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
 	var request storage.BucketV4Input
 	var list = schema.NewResources()
@@ -28,18 +39,34 @@ func (d *kodoProvider) GetResource(ctx context.Context) (*schema.Resources, erro
 			return nil, err
 		}
 		for _, bucket := range response.Buckets {
-			list.Append(&schema.Resource{
-				ID:       d.id,
-				Public:   true,
-				DNSName:  bucket.Name,
-				Provider: d.provider,
-			})
+			// 空间绑定的域名包括自定义域名和七牛云分配的测试域名
+			var domains []string
+			domainInfos, err := bucketManager.ListBucketDomains(bucket.Name)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 绑定的域名: %s", bucket.Name, err)
+			}
+			for _, domainInfo := range domainInfos {
+				domains = append(domains, domainInfo.Domain)
+			}
+			if s3Region, ok := s3Regions[bucket.Region]; ok {
+				domains = append(domains, bucket.Name+".s3."+s3Region+".qiniucs.com")
+			}
+			for _, domain := range domains {
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Public:     true,
+					DNSName:    domain,
+					Provider:   d.provider,
+					Service:    "Kodo",
+					Region:     bucket.Region,
+					ResourceID: bucket.Name,
+				})
+			}
 		}
-		if response.IsTruncated {
-			response.NextMarker = request.Marker
-		} else {
+		if !response.IsTruncated || response.NextMarker == "" {
 			break
 		}
+		request.Marker = response.NextMarker
 	}
 	return list, nil
 }
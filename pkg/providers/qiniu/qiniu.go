@@ -44,8 +44,17 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条七牛云 Kodo 对象存储信息", len(buckets.GetItems()))
+	cdnProvider := &cdnProvider{kodoClient: p.kodoClient, id: p.id, provider: p.provider, apiHost: cdnAPIHost}
+	domains, err := cdnProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条七牛云 CDN 域名信息", len(domains.GetItems()))
 	finalList := schema.NewResources()
 	finalList.Merge(buckets)
+	finalList.Merge(domains)
+	finalList.AddSummary("Kodo", len(buckets.GetItems()))
+	finalList.AddSummary("CDN", len(domains.GetItems()))
 	return finalList, nil
 }
 
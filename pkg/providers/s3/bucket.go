@@ -0,0 +1,93 @@
+package s3
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+type bucketProvider struct {
+	id        string
+	provider  string
+	config    providerConfig
+	endpoints []string
+
+	// 同一个配置文件中可能有多个 s3 配置块，因此每次获取时使用新的列表
+	list *schema.Resources
+}
+
+func (d *bucketProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.endpoints, d.listBuckets)
+	return d.list, nil
+}
+
+func (d *bucketProvider) listBuckets(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for endpoint := range ch {
+		gologger.Debug().Msgf("正在获取 %s 下的 S3 存储桶信息", endpoint)
+		endpointURL, parseErr := url.Parse(endpoint)
+		if parseErr != nil {
+			gologger.Debug().Msgf("无法解析 S3 地址 %s: %s", endpoint, parseErr)
+			continue
+		}
+		creds := credentials.NewStaticCredentials(d.config.accessKeyID, d.config.accessKeySecret, d.config.sessionToken)
+		s3Session, sessionErr := newSession(endpoint, d.config.region, d.config.pathStyle, creds)
+		if sessionErr != nil {
+			err = sessionErr
+			continue
+		}
+		output, listErr := awss3.New(s3Session).ListBuckets(&awss3.ListBucketsInput{})
+		if listErr != nil {
+			err = listErr
+			gologger.Debug().Msgf("无法获取 %s 下的存储桶: %s", endpoint, listErr)
+			continue
+		}
+		if len(output.Buckets) > 0 {
+			gologger.Warning().Msgf("在 %s 下获取到 %d 个存储桶", endpoint, len(output.Buckets))
+		}
+		// 使用路径访问时所有存储桶共用 endpoint 的地址，例如 MinIO 和部分 Ceph RGW 部署，IP 地址也只能使用路径访问
+		if d.config.pathStyle || net.ParseIP(endpointURL.Hostname()) != nil {
+			var buckets []string
+			for _, bucket := range output.Buckets {
+				buckets = append(buckets, aws.StringValue(bucket.Name))
+			}
+			if len(buckets) == 0 {
+				continue
+			}
+			d.list.Append(&schema.Resource{
+				ID:         d.id,
+				Provider:   d.provider,
+				Service:    "S3",
+				Region:     d.config.region,
+				ResourceID: endpoint,
+				DNSName:    endpointURL.Hostname(),
+				Public:     true,
+				Metadata:   map[string]string{"buckets": strings.Join(buckets, ", ")},
+			})
+			continue
+		}
+		for _, bucket := range output.Buckets {
+			bucketName := aws.StringValue(bucket.Name)
+			d.list.Append(&schema.Resource{
+				ID:         d.id,
+				Provider:   d.provider,
+				Service:    "S3",
+				Region:     d.config.region,
+				ResourceID: bucketName,
+				DNSName:    bucketName + "." + endpointURL.Hostname(),
+				Public:     true,
+				Metadata:   map[string]string{"endpoint": endpoint},
+			})
+		}
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package s3
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+)
+
+// BucketLister 通过云服务商的 S3 兼容接口获取存储桶，并按照 Host 模板生成每个存储桶的域名
+type BucketLister struct {
+	ID          string
+	Provider    string
+	Service     string // Service 为资产的服务名称，例如 TOS
+	Endpoint    string // Endpoint 为 S3 兼容接口的地址，为空时使用 AWS 的默认地址
+	Region      string // Region 为请求使用的区域，也是无法获取存储桶区域时的默认区域
+	PathStyle   bool
+	Credentials *credentials.Credentials
+	// Host 为存储桶域名的模板，{bucket} 和 {region} 会被替换为存储桶名称和区域，例如 {bucket}.tos-{region}.volces.com
+	Host string
+	// Regions 为 LocationConstraint 和 Region 与域名中区域名称的对应关系，为空时直接使用 LocationConstraint
+	Regions map[string]string
+}
+
+// GetResource 获取所有存储桶，没有权限时返回空列表，以便继续获取其他服务的资源
+func (d *BucketLister) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	s3Session, err := newSession(d.Endpoint, d.Region, d.PathStyle, d.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	s3Client := awss3.New(s3Session)
+	response, err := s3Client.ListBuckets(&awss3.ListBucketsInput{})
+	if err != nil {
+		gologger.Debug().Msgf("无法获取 %s 存储桶列表: %s", d.Service, err)
+		return list, nil
+	}
+	for _, bucket := range response.Buckets {
+		name := aws.StringValue(bucket.Name)
+		region := d.region("")
+		location, err := s3Client.GetBucketLocation(&awss3.GetBucketLocationInput{Bucket: bucket.Name})
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 存储桶的区域，使用默认区域 %s: %s", name, region, err)
+		} else {
+			region = d.region(aws.StringValue(location.LocationConstraint))
+		}
+		list.Append(&schema.Resource{
+			ID:         d.ID,
+			Public:     true,
+			DNSName:    strings.NewReplacer("{bucket}", name, "{region}", region).Replace(d.Host),
+			Provider:   d.Provider,
+			Service:    d.Service,
+			Region:     region,
+			ResourceID: name,
+		})
+	}
+	return list, nil
+}
+
+// region 把 LocationConstraint 转换为域名中的区域名称，无法转换时使用 Region，例如 AWS us-east-1 区域的 LocationConstraint 为空
+func (d *BucketLister) region(location string) string {
+	if region, ok := d.Regions[location]; ok {
+		return region
+	}
+	if location != "" && d.Regions == nil {
+		return location
+	}
+	if region, ok := d.Regions[d.Region]; ok {
+		return region
+	}
+	return d.Region
+}
+
+func newSession(endpoint, region string, pathStyle bool, creds *credentials.Credentials) (*session.Session, error) {
+	config := aws.NewConfig().
+		WithRegion(region).
+		WithS3ForcePathStyle(pathStyle).
+		WithCredentials(creds)
+	if endpoint != "" {
+		config.WithEndpoint(endpoint)
+	}
+	return session.NewSession(config)
+}
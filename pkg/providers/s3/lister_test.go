@@ -0,0 +1,94 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBucketListerHostTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			fmt.Fprint(w, `<ListAllMyBucketsResult><Buckets>`+
+				`<Bucket><Name>logs</Name></Bucket>`+
+				`<Bucket><Name>assets</Name></Bucket>`+
+				`<Bucket><Name>backup</Name></Bucket>`+
+				`</Buckets></ListAllMyBucketsResult>`)
+		case r.URL.Path == "/logs" && r.URL.Query().Has("location"):
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+		case r.URL.Path == "/assets" && r.URL.Query().Has("location"):
+			fmt.Fprint(w, `<LocationConstraint>SHANGHAI</LocationConstraint>`)
+		case r.URL.Path == "/backup" && r.URL.Query().Has("location"):
+			fmt.Fprint(w, `<LocationConstraint>UNKNOWN</LocationConstraint>`)
+		default:
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	lister := &BucketLister{
+		ID:          "test",
+		Provider:    "kingsoft",
+		Service:     "KS3",
+		Endpoint:    server.URL,
+		Region:      "BEIJING",
+		PathStyle:   true,
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		Host:        "{bucket}.ks3-{region}.ksyuncs.com",
+		Regions:     map[string]string{"BEIJING": "cn-beijing", "SHANGHAI": "cn-shanghai"},
+	}
+	list, err := lister.GetResource(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]string)
+	for _, item := range list.GetItems() {
+		if item.Service != "KS3" || item.Provider != "kingsoft" {
+			t.Errorf("资产的服务商或服务名称不正确: %+v", item)
+		}
+		names[item.DNSName] = item.Region
+	}
+	expected := map[string]string{
+		"logs.ks3-cn-beijing.ksyuncs.com":    "cn-beijing",
+		"assets.ks3-cn-shanghai.ksyuncs.com": "cn-shanghai",
+		"backup.ks3-cn-beijing.ksyuncs.com":  "cn-beijing",
+	}
+	if len(names) != len(expected) {
+		t.Fatalf("期望 %v，实际为 %v", expected, names)
+	}
+	for name, region := range expected {
+		if names[name] != region {
+			t.Errorf("%s 的区域应该为 %s，实际为 %q", name, region, names[name])
+		}
+	}
+}
+
+func TestBucketListerListBucketsFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+	}))
+	defer server.Close()
+
+	lister := &BucketLister{
+		Service:     "TOS",
+		Endpoint:    server.URL,
+		Region:      "cn-beijing",
+		PathStyle:   true,
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		Host:        "{bucket}.tos-{region}.volces.com",
+	}
+	list, err := lister.GetResource(context.Background())
+	if err != nil {
+		t.Fatalf("没有权限时不应该返回错误: %s", err)
+	}
+	if len(list.GetItems()) != 0 {
+		t.Errorf("不应该获取到存储桶: %v", list.GetItems())
+	}
+}
@@ -0,0 +1,105 @@
+package s3
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"strconv"
+	"strings"
+)
+
+type Provider struct {
+	id        string
+	provider  string
+	config    providerConfig
+	endpoints []string
+}
+
+type providerConfig struct {
+	accessKeyID     string
+	accessKeySecret string
+	sessionToken    string
+	region          string
+	pathStyle       bool
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	accessKeySecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.Endpoint}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	sessionToken, okST := options.GetMetadata(utils.SessionToken)
+
+	if okST {
+		gologger.Debug().Msg("找到 S3 临时访问凭证")
+	} else {
+		gologger.Debug().Msg("找到 S3 永久访问凭证")
+	}
+
+	// 大多数私有化部署的 S3 服务不校验区域，默认使用 us-east-1
+	region, ok := options.GetMetadata(utils.Region)
+	if !ok {
+		region = "us-east-1"
+	}
+	var pathStyle bool
+	if value, ok := options.GetMetadata(utils.PathStyle); ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		pathStyle = parsed
+	}
+
+	// 多个 endpoint 之间使用逗号分隔，未指定协议时使用 https
+	var endpoints []string
+	for _, item := range strings.Split(endpoint, ",") {
+		item = strings.TrimSuffix(strings.TrimSpace(item), "/")
+		if item == "" {
+			continue
+		}
+		if !strings.HasPrefix(item, "http://") && !strings.HasPrefix(item, "https://") {
+			item = "https://" + item
+		}
+		endpoints = append(endpoints, item)
+	}
+
+	config := providerConfig{
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		sessionToken:    sessionToken,
+		region:          region,
+		pathStyle:       pathStyle,
+	}
+	return &Provider{id: id, provider: utils.S3, config: config, endpoints: endpoints}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	bucketProvider := &bucketProvider{id: p.id, provider: p.provider, config: p.config, endpoints: p.endpoints}
+	bucketList, err := bucketProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 S3 存储桶信息", len(bucketList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(bucketList)
+	finalList.AddSummary("S3", len(bucketList.GetItems()))
+	return finalList, nil
+}
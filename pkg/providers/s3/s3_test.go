@@ -0,0 +1,143 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestServer 返回一个只响应 ListBuckets 请求的 S3 服务
+func newTestServer(t *testing.T, buckets ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/" {
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `<ListAllMyBucketsResult><Buckets>`)
+		for _, bucket := range buckets {
+			fmt.Fprintf(w, `<Bucket><Name>%s</Name></Bucket>`, bucket)
+		}
+		fmt.Fprint(w, `</Buckets></ListAllMyBucketsResult>`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// localhost 把 httptest 的地址改为域名，以便测试虚拟主机方式生成的存储桶域名
+func localhost(t *testing.T, server *httptest.Server) string {
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "http://localhost:" + serverURL.Port()
+}
+
+func TestNewParsesEndpoints(t *testing.T) {
+	provider, err := New(schema.OptionBlock{
+		utils.AccessKey: "minioadmin",
+		utils.SecretKey: "minioadmin",
+		utils.Endpoint:  " http://10.0.0.1:9000/ ,, s3.example.com,https://oss.example.com/",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"http://10.0.0.1:9000", "https://s3.example.com", "https://oss.example.com"}
+	if !reflect.DeepEqual(provider.endpoints, expected) {
+		t.Errorf("endpoint 解析错误，期望 %v，实际为 %v", expected, provider.endpoints)
+	}
+	if provider.config.region != "us-east-1" || provider.config.pathStyle {
+		t.Errorf("默认区域应该为 us-east-1 且不使用路径访问: %+v", provider.config)
+	}
+
+	provider, err = New(schema.OptionBlock{
+		utils.AccessKey: "minioadmin",
+		utils.SecretKey: "minioadmin",
+		utils.Endpoint:  "s3.example.com",
+		utils.PathStyle: "true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !provider.config.pathStyle {
+		t.Errorf("path_style 为 true 时应该使用路径访问")
+	}
+
+	_, err = New(schema.OptionBlock{
+		utils.AccessKey: "minioadmin",
+		utils.SecretKey: "minioadmin",
+		utils.Endpoint:  "s3.example.com",
+		utils.PathStyle: "yes",
+	})
+	if err == nil {
+		t.Errorf("path_style 不是布尔值时应该返回错误")
+	}
+}
+
+func TestResourcesVirtualHostAndPathStyle(t *testing.T) {
+	schema.SetThreads(2)
+	virtualHost := localhost(t, newTestServer(t, "logs", "assets"))
+	pathStyle := newTestServer(t, "backup", "static")
+	empty := newTestServer(t)
+
+	provider, err := New(schema.OptionBlock{
+		utils.AccessKey: "minioadmin",
+		utils.SecretKey: "minioadmin",
+		utils.Endpoint:  strings.Join([]string{virtualHost, pathStyle.URL, empty.URL}, ","),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := provider.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := make(map[string]*schema.Resource)
+	for _, item := range list.GetItems() {
+		items[item.ResourceID] = item
+	}
+	if len(items) != 3 {
+		t.Fatalf("应该得到 2 个虚拟主机方式的存储桶和 1 个路径访问的地址，实际为 %d 个: %v", len(items), items)
+	}
+	for _, bucket := range []string{"logs", "assets"} {
+		item := items[bucket]
+		if item == nil || item.DNSName != bucket+".localhost" || item.Metadata["endpoint"] != virtualHost {
+			t.Errorf("%s 存储桶的域名不正确: %+v", bucket, item)
+		}
+	}
+	// IP 地址只能使用路径访问，所有存储桶共用 endpoint 的地址
+	item := items[pathStyle.URL]
+	if item == nil || item.PrivateIpv4 != "127.0.0.1" || item.Metadata["buckets"] != "backup, static" {
+		t.Errorf("路径访问的资产不正确: %+v", item)
+	}
+}
+
+func TestResourcesPathStyleFlag(t *testing.T) {
+	schema.SetThreads(2)
+	endpoint := localhost(t, newTestServer(t, "logs", "assets"))
+
+	provider, err := New(schema.OptionBlock{
+		utils.AccessKey: "minioadmin",
+		utils.SecretKey: "minioadmin",
+		utils.Endpoint:  endpoint,
+		utils.PathStyle: "true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := provider.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := list.GetItems()
+	if len(items) != 1 || items[0].DNSName != "localhost" || items[0].Metadata["buckets"] != "logs, assets" {
+		t.Errorf("使用路径访问时应该只得到 endpoint 的地址: %v", items)
+	}
+}
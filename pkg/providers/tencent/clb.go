@@ -0,0 +1,139 @@
+package tencent
+
+import (
+	"context"
+	"fmt"
+	"github.com/projectdiscovery/gologger"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strings"
+	"sync"
+)
+
+type clbProvider struct {
+	id         string
+	provider   string
+	credential *common.Credential
+	cvmRegions []*cvm.RegionInfo
+	list       *schema.Resources
+}
+
+type clbLoadBalancer struct {
+	LoadBalancerId     string
+	LoadBalancerType   string
+	LoadBalancerVips   []string
+	AddressIPv6        string
+	Domain             string
+	LoadBalancerDomain string
+}
+
+type describeLoadBalancersResponse struct {
+	Response struct {
+		TotalCount      int
+		LoadBalancerSet []clbLoadBalancer
+	}
+}
+
+// describeLoadBalancersDetailResponse 中每一行是一个监听器，绑定了多个后端时会重复出现
+type describeLoadBalancersDetailResponse struct {
+	Response struct {
+		TotalCount            int
+		LoadBalancerDetailSet []struct {
+			LoadBalancerId string
+			ListenerId     string
+			Protocol       string
+			Port           int
+		}
+	}
+}
+
+func (d *clbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(cvmRegionNames(d.cvmRegions), d.describeLoadBalancers)
+	return d.list, nil
+}
+
+func (d *clbProvider) describeLoadBalancers(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的腾讯云 CLB 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeLoadBalancersResponse
+			params := map[string]interface{}{"Offset": offset, "Limit": 100}
+			err = commonRequest(d.credential, region, "clb", "2018-03-17", "DescribeLoadBalancers", params, &response)
+			if err != nil {
+				break
+			}
+			if len(response.Response.LoadBalancerSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 CLB 资源", region, len(response.Response.LoadBalancerSet))
+			}
+			var loadBalancerIds []string
+			for _, loadBalancer := range response.Response.LoadBalancerSet {
+				loadBalancerIds = append(loadBalancerIds, loadBalancer.LoadBalancerId)
+			}
+			listeners := d.describeListeners(region, loadBalancerIds)
+			for _, loadBalancer := range response.Response.LoadBalancerSet {
+				dnsName := loadBalancer.LoadBalancerDomain
+				if dnsName == "" {
+					dnsName = loadBalancer.Domain
+				}
+				metadata := map[string]string{"type": loadBalancer.LoadBalancerType}
+				if len(listeners[loadBalancer.LoadBalancerId]) > 0 {
+					metadata["listeners"] = strings.Join(listeners[loadBalancer.LoadBalancerId], ", ")
+				}
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "CLB",
+					Region:      region,
+					ResourceID:  loadBalancer.LoadBalancerId,
+					DNSName:     dnsName,
+					PublicIPv4s: loadBalancer.LoadBalancerVips,
+					IPv6s:       []string{loadBalancer.AddressIPv6},
+					Public:      loadBalancer.LoadBalancerType == "OPEN",
+					Metadata:    metadata,
+				})
+			}
+			if offset+100 >= response.Response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
+
+// describeListeners 批量返回负载均衡的监听端口，例如 TCP:80, HTTPS:443，key 为负载均衡 ID
+func (d *clbProvider) describeListeners(region string, loadBalancerIds []string) map[string][]string {
+	listeners := make(map[string][]string)
+	if len(loadBalancerIds) == 0 {
+		return listeners
+	}
+	seen := make(map[string]bool)
+	for offset := 0; ; offset += 100 {
+		var response describeLoadBalancersDetailResponse
+		params := map[string]interface{}{
+			"Offset":  offset,
+			"Limit":   100,
+			"Fields":  []string{"LoadBalancerId", "ListenerId", "Protocol", "Port"},
+			"Filters": []map[string]interface{}{{"Name": "loadbalancer-id", "Values": loadBalancerIds}},
+		}
+		err := commonRequest(d.credential, region, "clb", "2018-03-17", "DescribeLoadBalancersDetail", params, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 区域下 CLB 的监听器信息: %s", region, err)
+			break
+		}
+		for _, detail := range response.Response.LoadBalancerDetailSet {
+			if detail.ListenerId == "" || seen[detail.ListenerId] {
+				continue
+			}
+			seen[detail.ListenerId] = true
+			listeners[detail.LoadBalancerId] = append(listeners[detail.LoadBalancerId], fmt.Sprintf("%s:%d", detail.Protocol, detail.Port))
+		}
+		if offset+100 >= response.Response.TotalCount {
+			break
+		}
+	}
+	return listeners
+}
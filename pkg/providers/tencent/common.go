@@ -0,0 +1,37 @@
+package tencent
+
+import (
+	"encoding/json"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+// commonRequest 使用通用请求调用 SDK 中未引入的云服务 API，并将返回的 JSON 解析到 result 中，
+// result 需要包含 Response 字段，例如 struct{ Response struct{ TotalCount int } }
+func commonRequest(credential *common.Credential, region, service, version, action string, params map[string]interface{}, result interface{}) error {
+	cpf := profile.NewClientProfile()
+	cpf.HttpProfile.Endpoint = service + ".tencentcloudapi.com"
+	client := common.NewCommonClient(credential, region, cpf)
+
+	request := tchttp.NewCommonRequest(service, version, action)
+	request.SetScheme("https")
+	if err := request.SetActionParameters(params); err != nil {
+		return err
+	}
+	response := tchttp.NewCommonResponse()
+	if err := client.Send(request, response); err != nil {
+		return err
+	}
+	return json.Unmarshal(response.GetBody(), result)
+}
+
+// cvmRegionNames 返回所有 CVM 区域的名称
+func cvmRegionNames(cvmRegions []*cvm.RegionInfo) []string {
+	var regions []string
+	for _, region := range cvmRegions {
+		regions = append(regions, *region.Region)
+	}
+	return regions
+}
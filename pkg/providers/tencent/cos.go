@@ -28,10 +28,13 @@ func (d *cosProvider) GetResource(ctx context.Context) (*schema.Resources, error
 		endpointBuilder.WriteString("." + bucket.Region)
 		endpointBuilder.WriteString(".myqcloud.com")
 		cosList.Append(&schema.Resource{
-			ID:       d.id,
-			Public:   true,
-			DNSName:  endpointBuilder.String(),
-			Provider: d.provider,
+			ID:         d.id,
+			Public:     true,
+			DNSName:    endpointBuilder.String(),
+			Provider:   d.provider,
+			Service:    "COS",
+			Region:     bucket.Region,
+			ResourceID: bucket.Name,
 		})
 	}
 	return cosList, nil
@@ -16,39 +16,13 @@ type instanceProvider struct {
 	credential *common.Credential
 	cvmRegions []*cvm.RegionInfo
 	lhRegions  []*lh.RegionInfo
+	list       *schema.Resources
 }
 
-var cvmList = schema.NewResources()
-
 func (d *instanceProvider) GetCVMResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		//err     error
-		wg      sync.WaitGroup
-		regions []string
-	)
-	threads = schema.GetThreads()
-
-	for _, region := range d.cvmRegions {
-		regions = append(regions, *region.Region)
-	}
-
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			d.describeCVMInstances(taskCh, &wg)
-			//if err != nil {
-			//	return
-			//}
-		}()
-	}
-	for _, item := range regions {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
-	return cvmList, nil
+	d.list = schema.NewResources()
+	schema.RunWorkers(cvmRegionNames(d.cvmRegions), d.describeCVMInstances)
+	return d.list, nil
 }
 
 func (d *instanceProvider) describeCVMInstances(ch <-chan string, wg *sync.WaitGroup) error {
@@ -73,28 +47,18 @@ func (d *instanceProvider) describeCVMInstances(ch <-chan string, wg *sync.WaitG
 			continue
 		}
 		for _, instance := range response.Response.InstanceSet {
-			var (
-				ipv4        []string
-				privateIPv4 string
-			)
-
-			if len(instance.PublicIpAddresses) > 0 {
-				for _, v := range instance.PublicIpAddresses {
-					ipv4 = append(ipv4, *v)
-				}
-			}
-			if len(instance.PrivateIpAddresses) > 0 {
-				privateIPv4 = *instance.PrivateIpAddresses[0]
-			}
-			for _, v := range ipv4 {
-				cvmList.Append(&schema.Resource{
-					ID:          d.id,
-					Provider:    d.provider,
-					PublicIPv4:  v,
-					PrivateIpv4: privateIPv4,
-					Public:      v != "",
-				})
-			}
+			publicIPv4 := common.StringValues(instance.PublicIpAddresses)
+			d.list.Append(&schema.Resource{
+				ID:           d.id,
+				Provider:     d.provider,
+				Service:      "CVM",
+				Region:       region,
+				ResourceID:   *instance.InstanceId,
+				PublicIPv4s:  publicIPv4,
+				PrivateIpv4s: common.StringValues(instance.PrivateIpAddresses),
+				IPv6s:        common.StringValues(instance.IPv6Addresses),
+				Public:       len(publicIPv4) > 0 || len(instance.IPv6Addresses) > 0,
+			})
 		}
 	}
 	return err
@@ -0,0 +1,257 @@
+package tencent
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net"
+	"strconv"
+	"sync"
+)
+
+type databaseProvider struct {
+	id         string
+	provider   string
+	credential *common.Credential
+	cvmRegions []*cvm.RegionInfo
+	list       *schema.Resources
+}
+
+type describeCdbInstancesResponse struct {
+	Response struct {
+		TotalCount int
+		Items      []struct {
+			InstanceId string
+			Vip        string
+			Vport      int
+			WanDomain  string
+			WanPort    int
+			WanStatus  int
+		}
+	}
+}
+
+type describeRedisInstancesResponse struct {
+	Response struct {
+		TotalCount  int
+		InstanceSet []struct {
+			InstanceId string
+			WanIp      string
+			Port       int
+			WanAddress string
+		}
+	}
+}
+
+type describeMongoInstancesResponse struct {
+	Response struct {
+		TotalCount      int
+		InstanceDetails []struct {
+			InstanceId string
+			Vip        string
+			Vport      int
+		}
+	}
+}
+
+// describeMongoNodePropertyResponse 中开通外网访问的节点会返回 WanServiceAddress
+type describeMongoNodePropertyResponse struct {
+	Response struct {
+		Mongos        []mongoNodeProperty
+		ReplicateSets []struct {
+			Nodes []mongoNodeProperty
+		}
+	}
+}
+
+type mongoNodeProperty struct {
+	WanServiceAddress string
+}
+
+func (d *databaseProvider) GetCdbResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(cvmRegionNames(d.cvmRegions), d.describeCdbInstances)
+	return d.list, nil
+}
+
+func (d *databaseProvider) GetRedisResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(cvmRegionNames(d.cvmRegions), d.describeRedisInstances)
+	return d.list, nil
+}
+
+func (d *databaseProvider) GetMongoResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(cvmRegionNames(d.cvmRegions), d.describeMongoInstances)
+	return d.list, nil
+}
+
+func (d *databaseProvider) describeCdbInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的腾讯云 CDB 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeCdbInstancesResponse
+			params := map[string]interface{}{"Offset": offset, "Limit": 100}
+			err = commonRequest(d.credential, region, "cdb", "2017-03-20", "DescribeDBInstances", params, &response)
+			if err != nil {
+				break
+			}
+			if len(response.Response.Items) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 CDB 资源", region, len(response.Response.Items))
+			}
+			for _, instance := range response.Response.Items {
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "CDB",
+					Region:      region,
+					ResourceID:  instance.InstanceId,
+					PrivateIpv4: instance.Vip,
+					Metadata:    map[string]string{"port": strconv.Itoa(instance.Vport)},
+				})
+				// WanStatus 为 1 时表示已开通外网访问
+				if instance.WanStatus == 1 && instance.WanDomain != "" {
+					d.list.Append(&schema.Resource{
+						ID:         d.id,
+						Provider:   d.provider,
+						Service:    "CDB",
+						Region:     region,
+						ResourceID: instance.InstanceId,
+						DNSName:    instance.WanDomain,
+						Public:     true,
+						Metadata:   map[string]string{"port": strconv.Itoa(instance.WanPort)},
+					})
+				}
+			}
+			if offset+100 >= response.Response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
+
+func (d *databaseProvider) describeRedisInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的腾讯云 Redis 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeRedisInstancesResponse
+			params := map[string]interface{}{"Offset": offset, "Limit": 100}
+			err = commonRequest(d.credential, region, "redis", "2018-04-12", "DescribeInstances", params, &response)
+			if err != nil {
+				break
+			}
+			if len(response.Response.InstanceSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 Redis 资源", region, len(response.Response.InstanceSet))
+			}
+			for _, instance := range response.Response.InstanceSet {
+				// Redis 的 WanIp 字段实际上是实例的内网 VIP
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "Redis",
+					Region:      region,
+					ResourceID:  instance.InstanceId,
+					PrivateIpv4: instance.WanIp,
+					Metadata:    map[string]string{"port": strconv.Itoa(instance.Port)},
+				})
+				// WanAddress 为开通外网访问后的地址，格式为 host:port
+				if instance.WanAddress != "" {
+					d.list.Append(d.wanResource("Redis", region, instance.InstanceId, instance.WanAddress))
+				}
+			}
+			if offset+100 >= response.Response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
+
+func (d *databaseProvider) describeMongoInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的腾讯云 MongoDB 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeMongoInstancesResponse
+			params := map[string]interface{}{"Offset": offset, "Limit": 100}
+			err = commonRequest(d.credential, region, "mongodb", "2019-07-25", "DescribeDBInstances", params, &response)
+			if err != nil {
+				break
+			}
+			if len(response.Response.InstanceDetails) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 MongoDB 资源", region, len(response.Response.InstanceDetails))
+			}
+			for _, instance := range response.Response.InstanceDetails {
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "MongoDB",
+					Region:      region,
+					ResourceID:  instance.InstanceId,
+					PrivateIpv4: instance.Vip,
+					Metadata:    map[string]string{"port": strconv.Itoa(instance.Vport)},
+				})
+				for _, address := range d.describeMongoWanAddresses(region, instance.InstanceId) {
+					d.list.Append(d.wanResource("MongoDB", region, instance.InstanceId, address))
+				}
+			}
+			if offset+100 >= response.Response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
+
+// describeMongoWanAddresses 返回 MongoDB 实例中开通了外网访问的节点地址
+func (d *databaseProvider) describeMongoWanAddresses(region, instanceId string) []string {
+	var response describeMongoNodePropertyResponse
+	params := map[string]interface{}{"InstanceId": instanceId}
+	err := commonRequest(d.credential, region, "mongodb", "2019-07-25", "DescribeDBInstanceNodeProperty", params, &response)
+	if err != nil {
+		gologger.Debug().Msgf("无法获取 %s 的节点信息: %s", instanceId, err)
+		return nil
+	}
+	nodes := response.Response.Mongos
+	for _, replicateSet := range response.Response.ReplicateSets {
+		nodes = append(nodes, replicateSet.Nodes...)
+	}
+	var addresses []string
+	for _, node := range nodes {
+		if node.WanServiceAddress != "" {
+			addresses = append(addresses, node.WanServiceAddress)
+		}
+	}
+	return addresses
+}
+
+// wanResource 把 host:port 格式的外网地址转换为公网资产，host 可以是 IP 或者域名
+func (d *databaseProvider) wanResource(service, region, instanceId, address string) *schema.Resource {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = address, ""
+	}
+	resource := &schema.Resource{
+		ID:         d.id,
+		Provider:   d.provider,
+		Service:    service,
+		Region:     region,
+		ResourceID: instanceId,
+		Public:     true,
+		Metadata:   map[string]string{"port": port},
+	}
+	if net.ParseIP(host) != nil {
+		resource.PublicIPv4 = host
+	} else {
+		resource.DNSName = host
+	}
+	return resource
+}
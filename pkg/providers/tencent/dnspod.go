@@ -0,0 +1,92 @@
+package tencent
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/wgpsec/lc/pkg/schema"
+	"strconv"
+)
+
+type dnspodProvider struct {
+	id         string
+	provider   string
+	credential *common.Credential
+}
+
+type describeDomainListResponse struct {
+	Response struct {
+		DomainCountInfo struct {
+			AllTotal int
+		}
+		DomainList []struct {
+			DomainId int
+			Name     string
+		}
+	}
+}
+
+type describeRecordListResponse struct {
+	Response struct {
+		RecordCountInfo struct {
+			TotalCount int
+		}
+		RecordList []struct {
+			RecordId int
+			Name     string
+			Type     string
+			Value    string
+		}
+	}
+}
+
+func (d *dnspodProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取腾讯云 DNSPod 资源信息")
+	for offset := 0; ; offset += 3000 {
+		var response describeDomainListResponse
+		params := map[string]interface{}{"Offset": offset, "Limit": 3000}
+		err := commonRequest(d.credential, "", "dnspod", "2021-03-23", "DescribeDomainList", params, &response)
+		if err != nil {
+			// 账号下没有域名时 DNSPod 也会返回 ResourceNotFound.NoDataOfDomain 错误
+			gologger.Debug().Msgf("无法获取 DNSPod 域名列表: %s", err)
+			break
+		}
+		for _, domain := range response.Response.DomainList {
+			records := d.describeRecordList(domain.Name)
+			if records.Len() > 0 {
+				gologger.Warning().Msgf("在 %s 域名下获取到 %d 条解析记录", domain.Name, records.Len())
+			}
+			records.AppendTo(list, schema.Resource{ID: d.id, Provider: d.provider, Service: "DNSPod"}, domain.Name)
+		}
+		if offset+3000 >= response.Response.DomainCountInfo.AllTotal {
+			break
+		}
+	}
+	return list, nil
+}
+
+// describeRecordList 按照完整的域名聚合域名下所有 A、AAAA 和 CNAME 解析记录
+func (d *dnspodProvider) describeRecordList(domainName string) *schema.DNSRecords {
+	records := schema.NewDNSRecords()
+	for offset := 0; ; offset += 3000 {
+		var response describeRecordListResponse
+		params := map[string]interface{}{"Domain": domainName, "Offset": offset, "Limit": 3000}
+		err := commonRequest(d.credential, "", "dnspod", "2021-03-23", "DescribeRecordList", params, &response)
+		if err != nil {
+			gologger.Debug().Msgf("无法获取 %s 的解析记录: %s", domainName, err)
+			break
+		}
+		for _, record := range response.Response.RecordList {
+			fqdn := domainName
+			if record.Name != "@" {
+				fqdn = record.Name + "." + domainName
+			}
+			records.Add(fqdn, record.Type, record.Value, strconv.Itoa(record.RecordId))
+		}
+		if offset+3000 >= response.Response.RecordCountInfo.TotalCount {
+			break
+		}
+	}
+	return records
+}
@@ -2,89 +2,63 @@ package tencent
 
 import (
 	"context"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
-	lh "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
+	"github.com/projectdiscovery/gologger"
 	"github.com/wgpsec/lc/pkg/schema"
 	"sync"
 )
 
-var lhList = schema.NewResources()
+// describeLHInstancesResponse 只包含需要的字段，SDK 中的 Instance 还没有 IPv6 地址，因此使用通用请求
+type describeLHInstancesResponse struct {
+	Response struct {
+		TotalCount  int
+		InstanceSet []struct {
+			InstanceId           string
+			PublicAddresses      []string
+			PrivateAddresses     []string
+			PublicIpv6Addresses  []string
+			PrivateIpv6Addresses []string
+		}
+	}
+}
 
 func (d *instanceProvider) GetLHResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		err     error
-		wg      sync.WaitGroup
-		regions []string
-	)
-	threads = schema.GetThreads()
-
+	d.list = schema.NewResources()
+	var regions []string
 	for _, region := range d.lhRegions {
 		regions = append(regions, *region.Region)
 	}
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.describeLHInstances(taskCh, &wg)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range regions {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
-	return lhList, nil
+	schema.RunWorkers(regions, d.describeLHInstances)
+	return d.list, nil
 }
 
 func (d *instanceProvider) describeLHInstances(ch <-chan string, wg *sync.WaitGroup) error {
 	defer wg.Done()
-	var (
-		err      error
-		lhClient *lh.Client
-		response *lh.DescribeInstancesResponse
-	)
+	var err error
 	for region := range ch {
-		cpf := profile.NewClientProfile()
-		cpf.HttpProfile.Endpoint = "lighthouse.tencentcloudapi.com"
-		lhClient, err = lh.NewClient(d.credential, region, cpf)
-		if err != nil {
-			continue
-		}
-		request := lh.NewDescribeInstancesRequest()
-		request.Limit = common.Int64Ptr(100)
-		request.SetScheme("https")
-		response, err = lhClient.DescribeInstances(request)
-		if err != nil {
-			continue
-		}
-		for _, instance := range response.Response.InstanceSet {
-			var (
-				ipv4        []string
-				privateIPv4 string
-			)
-
-			if len(instance.PublicAddresses) > 0 {
-				for _, v := range instance.PublicAddresses {
-					ipv4 = append(ipv4, *v)
-				}
-			}
-			if len(instance.PrivateAddresses) > 0 {
-				privateIPv4 = *instance.PrivateAddresses[0]
+		gologger.Debug().Msgf("正在获取 %s 区域下的腾讯云 Lighthouse 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeLHInstancesResponse
+			params := map[string]interface{}{"Offset": offset, "Limit": 100}
+			err = commonRequest(d.credential, region, "lighthouse", "2020-03-24", "DescribeInstances", params, &response)
+			if err != nil {
+				break
 			}
-			for _, v := range ipv4 {
-				lhList.Append(&schema.Resource{
-					ID:          d.id,
-					Provider:    d.provider,
-					PublicIPv4:  v,
-					PrivateIpv4: privateIPv4,
-					Public:      v != "",
+			for _, instance := range response.Response.InstanceSet {
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "Lighthouse",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					PublicIPv4s:  instance.PublicAddresses,
+					PrivateIpv4s: instance.PrivateAddresses,
+					IPv6s:        append(instance.PublicIpv6Addresses, instance.PrivateIpv6Addresses...),
+					Public:       len(instance.PublicAddresses) > 0 || len(instance.PublicIpv6Addresses) > 0,
 				})
 			}
+			if offset+100 >= response.Response.TotalCount {
+				break
+			}
 		}
 	}
 	return err
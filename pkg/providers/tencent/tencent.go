@@ -117,9 +117,53 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 	}
 	gologger.Info().Msgf("获取到 %d 条腾讯云 COS 信息", len(cosList.GetItems()))
 
+	clbProvider := &clbProvider{id: p.id, provider: p.provider, cvmRegions: p.cvmRegions, credential: p.credential}
+	clbList, err := clbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条腾讯云 CLB 信息", len(clbList.GetItems()))
+
+	dnspodProvider := &dnspodProvider{id: p.id, provider: p.provider, credential: p.credential}
+	dnspodList, err := dnspodProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条腾讯云 DNSPod 解析信息", len(dnspodList.GetItems()))
+
+	dbProvider := &databaseProvider{id: p.id, provider: p.provider, cvmRegions: p.cvmRegions, credential: p.credential}
+	cdbList, err := dbProvider.GetCdbResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条腾讯云 CDB 信息", len(cdbList.GetItems()))
+	redisList, err := dbProvider.GetRedisResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条腾讯云 Redis 信息", len(redisList.GetItems()))
+	mongoList, err := dbProvider.GetMongoResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条腾讯云 MongoDB 信息", len(mongoList.GetItems()))
+
 	finalList := schema.NewResources()
 	finalList.Merge(cvmList)
 	finalList.Merge(lhList)
 	finalList.Merge(cosList)
+	finalList.Merge(clbList)
+	finalList.Merge(dnspodList)
+	finalList.Merge(cdbList)
+	finalList.Merge(redisList)
+	finalList.Merge(mongoList)
+	finalList.AddSummary("CVM", len(cvmList.GetItems()))
+	finalList.AddSummary("Lighthouse", len(lhList.GetItems()))
+	finalList.AddSummary("COS", len(cosList.GetItems()))
+	finalList.AddSummary("CLB", len(clbList.GetItems()))
+	finalList.AddSummary("DNSPod", len(dnspodList.GetItems()))
+	finalList.AddSummary("CDB", len(cdbList.GetItems()))
+	finalList.AddSummary("Redis", len(redisList.GetItems()))
+	finalList.AddSummary("MongoDB", len(mongoList.GetItems()))
 	return finalList, nil
 }
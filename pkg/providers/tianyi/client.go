@@ -0,0 +1,144 @@
+package tianyi
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const eopDateFormat = "20060102T150405Z"
+
+// eopClient 使用 EOP 签名调用天翼云 OpenAPI
+type eopClient struct {
+	accessKey  string
+	secretKey  string
+	httpClient *http.Client
+}
+
+// eopResponse 是天翼云 OpenAPI 的通用返回结构，statusCode 为 800 时表示成功
+type eopResponse struct {
+	StatusCode  interface{}     `json:"statusCode"`
+	Message     string          `json:"message"`
+	Description string          `json:"description"`
+	ReturnObj   json.RawMessage `json:"returnObj"`
+}
+
+func newEopClient(accessKey, secretKey string) *eopClient {
+	return &eopClient{
+		accessKey:  accessKey,
+		secretKey:  secretKey,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// get 向 https://{host}{path} 发送已签名的 GET 请求，并将 returnObj 解析到 result 中
+func (c *eopClient) get(host, path string, query url.Values, result interface{}) error {
+	return c.do(http.MethodGet, host, path, query, nil, result)
+}
+
+// post 向 https://{host}{path} 发送已签名的 JSON POST 请求，并将 returnObj 解析到 result 中
+func (c *eopClient) post(host, path string, params map[string]interface{}, result interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, host, path, nil, body, result)
+}
+
+func (c *eopClient) do(method, host, path string, query url.Values, body []byte, result interface{}) error {
+	queryString := canonicalQueryString(query)
+	requestURL := "https://" + host + path
+	if queryString != "" {
+		requestURL += "?" + queryString
+	}
+	request, err := http.NewRequest(method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	c.sign(request, queryString, body)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(data))
+	}
+	var eopResult eopResponse
+	if err = json.Unmarshal(data, &eopResult); err != nil {
+		return err
+	}
+	if fmt.Sprint(eopResult.StatusCode) != "800" {
+		return fmt.Errorf("%s: %v %s %s", path, eopResult.StatusCode, eopResult.Message, eopResult.Description)
+	}
+	return json.Unmarshal(eopResult.ReturnObj, result)
+}
+
+// sign 为请求添加 ctyun-eop-request-id、Eop-date 和 Eop-Authorization 头
+func (c *eopClient) sign(request *http.Request, queryString string, body []byte) {
+	// Eop-date 使用北京时间
+	eopDate := time.Now().In(time.FixedZone("CST", 8*3600)).Format(eopDateFormat)
+	requestId := newRequestId()
+	request.Header.Set("ctyun-eop-request-id", requestId)
+	request.Header.Set("Eop-date", eopDate)
+
+	payloadHash := sha256.Sum256(body)
+	stringToSign := "ctyun-eop-request-id:" + requestId + "\n" +
+		"eop-date:" + eopDate + "\n" + "\n" +
+		queryString + "\n" +
+		hex.EncodeToString(payloadHash[:])
+
+	kTime := hmacSHA256([]byte(c.secretKey), eopDate)
+	kAk := hmacSHA256(kTime, c.accessKey)
+	kDate := hmacSHA256(kAk, eopDate[:8])
+	signature := base64.StdEncoding.EncodeToString(hmacSHA256(kDate, stringToSign))
+
+	request.Header.Set("Eop-Authorization", c.accessKey+" Headers=ctyun-eop-request-id;eop-date Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func canonicalQueryString(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, key+"="+url.QueryEscape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// newRequestId 生成 UUID 格式的请求 ID
+func newRequestId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,95 @@
+package tianyi
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type ecsProvider struct {
+	id        string
+	provider  string
+	eopClient *eopClient
+	regions   []region
+	list      *schema.Resources
+}
+
+type listInstancesResult struct {
+	TotalCount int `json:"totalCount"`
+	Results    []struct {
+		InstanceID   string `json:"instanceID"`
+		InstanceName string `json:"instanceName"`
+		PrivateIP    string `json:"privateIP"`
+		PrivateIPv6  string `json:"privateIPv6"`
+		FloatingIP   string `json:"floatingIP"`
+		Addresses    []struct {
+			AddressList []struct {
+				Addr    string `json:"addr"`
+				Version int    `json:"version"`
+				Type    string `json:"type"`
+			} `json:"addressList"`
+		} `json:"addresses"`
+	} `json:"results"`
+}
+
+func (d *ecsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.listInstances)
+	return d.list, nil
+}
+
+func (d *ecsProvider) listInstances(ch <-chan region, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 资源池下的天翼云 ECS 资源信息", item.Name)
+		for pageNo := 1; ; pageNo++ {
+			var result listInstancesResult
+			params := map[string]interface{}{"regionID": item.ID, "pageNo": pageNo, "pageSize": 50}
+			err = d.eopClient.post(ecsHost, "/v4/ecs/list-instances", params, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 资源池下的 ECS 资源: %s", item.Name, err)
+				break
+			}
+			if len(result.Results) > 0 {
+				gologger.Warning().Msgf("在 %s 资源池下获取到 %d 条 ECS 资源", item.Name, len(result.Results))
+			}
+			for _, instance := range result.Results {
+				var (
+					publicIPv4s  = []string{instance.FloatingIP}
+					privateIPv4s = []string{instance.PrivateIP}
+					ipv6s        = []string{instance.PrivateIPv6}
+				)
+				for _, address := range instance.Addresses {
+					for _, addr := range address.AddressList {
+						switch {
+						case addr.Version == 6:
+							ipv6s = append(ipv6s, addr.Addr)
+						case addr.Type == "floating":
+							publicIPv4s = append(publicIPv4s, addr.Addr)
+						default:
+							privateIPv4s = append(privateIPv4s, addr.Addr)
+						}
+					}
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "ECS",
+					Region:       item.ID,
+					ResourceID:   instance.InstanceID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					IPv6s:        ipv6s,
+					Public:       instance.FloatingIP != "",
+					Metadata:     map[string]string{"name": instance.InstanceName},
+				})
+			}
+			if pageNo*50 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
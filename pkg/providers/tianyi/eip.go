@@ -0,0 +1,79 @@
+package tianyi
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"sync"
+)
+
+type eipProvider struct {
+	id        string
+	provider  string
+	eopClient *eopClient
+	regions   []region
+	list      *schema.Resources
+}
+
+type listEipsResult struct {
+	TotalCount int `json:"totalCount"`
+	Eips       []struct {
+		ID               string `json:"ID"`
+		Name             string `json:"name"`
+		EipAddress       string `json:"eipAddress"`
+		Status           string `json:"status"`
+		AssociationID    string `json:"associationID"`
+		AssociationType  string `json:"associationType"`
+		PrivateIpAddress string `json:"privateIpAddress"`
+	} `json:"eips"`
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.listEips)
+	return d.list, nil
+}
+
+func (d *eipProvider) listEips(ch <-chan region, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for item := range ch {
+		gologger.Debug().Msgf("正在获取 %s 资源池下的天翼云 EIP 资源信息", item.Name)
+		for pageNo := 1; ; pageNo++ {
+			var result listEipsResult
+			params := map[string]interface{}{"regionID": item.ID, "pageNo": pageNo, "pageSize": 50}
+			err = d.eopClient.post(vpcHost, "/v4/eip/new-list", params, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 资源池下的 EIP 资源: %s", item.Name, err)
+				break
+			}
+			if len(result.Eips) > 0 {
+				gologger.Warning().Msgf("在 %s 资源池下获取到 %d 条 EIP 资源", item.Name, len(result.Eips))
+			}
+			for _, eip := range result.Eips {
+				metadata := map[string]string{"status": eip.Status}
+				if eip.AssociationID != "" {
+					metadata["instance_id"] = eip.AssociationID
+					metadata["instance_type"] = eip.AssociationType
+				}
+				if eip.PrivateIpAddress != "" {
+					metadata["bind_private_ip"] = eip.PrivateIpAddress
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     item.ID,
+					ResourceID: eip.ID,
+					PublicIPv4: eip.EipAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if pageNo*50 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -12,6 +12,7 @@ type oosProvider struct {
 	id        string
 	provider  string
 	oosClient *oos.Client
+	endpoint  string
 }
 
 func (d *oosProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
@@ -22,14 +23,22 @@ func (d *oosProvider) GetResource(ctx context.Context) (*schema.Resources, error
 		return nil, err
 	}
 	for _, bucket := range response.Buckets {
+		var region string
+		location, err := d.oosClient.GetBucketLocation(bucket.Name)
+		if err == nil {
+			region = location.MetaLocation
+		}
 		endpointBuilder := &strings.Builder{}
 		endpointBuilder.WriteString(bucket.Name)
-		endpointBuilder.WriteString(".oos-cn.ctyunapi.cn")
+		endpointBuilder.WriteString("." + d.endpoint)
 		list.Append(&schema.Resource{
-			ID:       d.id,
-			Public:   true,
-			DNSName:  endpointBuilder.String(),
-			Provider: d.provider,
+			ID:         d.id,
+			Public:     true,
+			DNSName:    endpointBuilder.String(),
+			Provider:   d.provider,
+			Service:    "OOS",
+			Region:     region,
+			ResourceID: bucket.Name,
 		})
 	}
 	return list, nil
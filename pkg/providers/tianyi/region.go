@@ -0,0 +1,27 @@
+package tianyi
+
+import (
+	"net/url"
+)
+
+const (
+	ecsHost = "ctecs-global.ctapi.ctyun.cn"
+	vpcHost = "ctvpc-global.ctapi.ctyun.cn"
+)
+
+// region 是天翼云的资源池
+type region struct {
+	ID   string `json:"regionID"`
+	Name string `json:"regionName"`
+}
+
+// listRegions 返回当前账号可以使用的所有资源池
+func listRegions(client *eopClient) ([]region, error) {
+	var result struct {
+		RegionList []region `json:"regionList"`
+	}
+	if err := client.get(ecsHost, "/v4/region/list-regions", url.Values{}, &result); err != nil {
+		return nil, err
+	}
+	return result.RegionList, nil
+}
@@ -6,12 +6,16 @@ import (
 	"github.com/teamssix/oos-go-sdk/oos"
 	"github.com/wgpsec/lc/pkg/schema"
 	"github.com/wgpsec/lc/utils"
+	"strings"
 )
 
 type Provider struct {
 	id        string
 	provider  string
 	oosClient *oos.Client
+	endpoint  string
+	eopClient *eopClient
+	regions   []region
 }
 
 func New(options schema.OptionBlock) (*Provider, error) {
@@ -28,30 +32,64 @@ func New(options schema.OptionBlock) (*Provider, error) {
 		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
 	}
 	id, _ := options.GetMetadata(utils.Id)
+	// 默认使用 OOS 中心节点，也可以配置为资源池节点，例如 oos-hazz.ctyunapi.cn
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "oos-cn.ctyunapi.cn"
+	}
+	endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
 
 	gologger.Debug().Msg("找到天翼云访问永久访问凭证")
 
 	// oos client
 	clientOptionV4 := oos.V4Signature(true)
 	isEnableSha256 := oos.EnableSha256ForPayload(true)
-	oosClient, err = oos.New("https://oos-cn.ctyunapi.cn", accessKeyID, accessKeySecret, clientOptionV4, isEnableSha256)
+	oosClient, err = oos.New("https://"+endpoint, accessKeyID, accessKeySecret, clientOptionV4, isEnableSha256)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Provider{provider: utils.TianYi, id: id, oosClient: oosClient}, nil
+	// regions
+	eopClient := newEopClient(accessKeyID, accessKeySecret)
+	regions, err := listRegions(eopClient)
+	if err != nil {
+		// OOS 和 OpenAPI 使用不同的访问凭证时仍然可以获取 OOS 资源
+		gologger.Debug().Msgf("无法获取天翼云资源池列表: %s", err)
+	}
+
+	return &Provider{provider: utils.TianYi, id: id, oosClient: oosClient, endpoint: endpoint, eopClient: eopClient, regions: regions}, nil
 }
 
 func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 	var err error
-	oosProvider := &oosProvider{oosClient: p.oosClient, id: p.id, provider: p.provider}
+	oosProvider := &oosProvider{oosClient: p.oosClient, endpoint: p.endpoint, id: p.id, provider: p.provider}
 	buckets, err := oosProvider.GetResource(ctx)
 	if err != nil {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条天翼云 OOS 对象存储信息", len(buckets.GetItems()))
+
+	ecsProvider := &ecsProvider{id: p.id, provider: p.provider, eopClient: p.eopClient, regions: p.regions}
+	ecsList, err := ecsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条天翼云 ECS 信息", len(ecsList.GetItems()))
+
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, eopClient: p.eopClient, regions: p.regions}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条天翼云 EIP 信息", len(eipList.GetItems()))
+
 	finalList := schema.NewResources()
 	finalList.Merge(buckets)
+	finalList.Merge(ecsList)
+	finalList.Merge(eipList)
+	finalList.AddSummary("OOS", len(buckets.GetItems()))
+	finalList.AddSummary("ECS", len(ecsList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
 	return finalList, nil
 }
 
@@ -0,0 +1,81 @@
+package ucloud
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// apiClient 使用公私钥签名调用 UCloud API
+type apiClient struct {
+	publicKey  string
+	privateKey string
+	httpClient *http.Client
+}
+
+// apiResponse 是 UCloud API 的通用返回结构，RetCode 为 0 时表示成功
+type apiResponse struct {
+	RetCode int
+	Message string
+}
+
+func newAPIClient(publicKey, privateKey string) *apiClient {
+	return &apiClient{
+		publicKey:  publicKey,
+		privateKey: privateKey,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// call 调用 action 接口，并将返回的 JSON 解析到 result 中
+func (c *apiClient) call(action string, params url.Values, result interface{}) error {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("Action", action)
+	query.Set("PublicKey", c.publicKey)
+	query.Set("Signature", c.sign(query))
+
+	response, err := c.httpClient.Get("https://api.ucloud.cn/?" + query.Encode())
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	var apiResult apiResponse
+	if err = json.Unmarshal(body, &apiResult); err != nil {
+		return fmt.Errorf("%s %s: %s", response.Status, action, bytes.TrimSpace(body))
+	}
+	if apiResult.RetCode != 0 {
+		return fmt.Errorf("%s: %d %s", action, apiResult.RetCode, apiResult.Message)
+	}
+	return json.Unmarshal(body, result)
+}
+
+// sign 将参数按照名称排序后拼接，末尾加上私钥后计算 SHA1
+func (c *apiClient) sign(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(key + query.Get(key))
+	}
+	builder.WriteString(c.privateKey)
+	hash := sha1.Sum([]byte(builder.String()))
+	return hex.EncodeToString(hash[:])
+}
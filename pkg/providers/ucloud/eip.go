@@ -0,0 +1,85 @@
+package ucloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type eipProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	regions   []string
+	list      *schema.Resources
+}
+
+type describeEIPResponse struct {
+	TotalCount int
+	EIPSet     []struct {
+		EIPId   string
+		Status  string
+		EIPAddr []struct {
+			IP           string
+			OperatorName string
+		}
+		Resource struct {
+			ResourceID   string
+			ResourceType string
+		}
+	}
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeEIP)
+	return d.list, nil
+}
+
+func (d *eipProvider) describeEIP(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 UCloud EIP 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeEIPResponse
+			params := url.Values{"Region": {region}, "Offset": {strconv.Itoa(offset)}, "Limit": {"100"}}
+			err = d.apiClient.call("DescribeEIP", params, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 EIP 资源: %s", region, err)
+				break
+			}
+			if len(response.EIPSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(response.EIPSet))
+			}
+			for _, eip := range response.EIPSet {
+				var ips []string
+				for _, addr := range eip.EIPAddr {
+					ips = append(ips, addr.IP)
+				}
+				metadata := map[string]string{"status": eip.Status}
+				if eip.Resource.ResourceID != "" {
+					metadata["instance_id"] = eip.Resource.ResourceID
+					metadata["instance_type"] = eip.Resource.ResourceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "EIP",
+					Region:      region,
+					ResourceID:  eip.EIPId,
+					PublicIPv4s: ips,
+					Public:      true,
+					Metadata:    metadata,
+				})
+			}
+			if offset+100 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package ucloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	regions   []string
+}
+
+type getRegionResponse struct {
+	Regions []struct {
+		Region string
+	}
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	publicKey, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	privateKey, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+
+	gologger.Debug().Msg("找到 UCloud 永久访问凭证")
+
+	// regions
+	apiClient := newAPIClient(publicKey, privateKey)
+	var response getRegionResponse
+	if err := apiClient.call("GetRegion", nil, &response); err != nil {
+		return nil, err
+	}
+	// GetRegion 会返回每个可用区，需要对区域去重
+	var regions []string
+	for _, item := range response.Regions {
+		regions = append(regions, item.Region)
+	}
+	regions = utils.RemoveRepeatedElement(regions)
+
+	return &Provider{id: id, provider: utils.UCloud, apiClient: apiClient, regions: regions}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	uhostProvider := &uhostProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, regions: p.regions}
+	uhostList, err := uhostProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 UCloud UHost 信息", len(uhostList.GetItems()))
+
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, apiClient: p.apiClient, regions: p.regions}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 UCloud EIP 信息", len(eipList.GetItems()))
+
+	ufileProvider := &ufileProvider{id: p.id, provider: p.provider, apiClient: p.apiClient}
+	ufileList, err := ufileProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条 UCloud UFile 信息", len(ufileList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(uhostList)
+	finalList.Merge(eipList)
+	finalList.Merge(ufileList)
+	finalList.AddSummary("UHost", len(uhostList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("UFile", len(ufileList.GetItems()))
+	return finalList, nil
+}
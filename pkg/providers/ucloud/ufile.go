@@ -0,0 +1,64 @@
+package ucloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+)
+
+type ufileProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+}
+
+type describeBucketResponse struct {
+	DataSet []struct {
+		BucketName string
+		BucketId   string
+		Region     string
+		Domain     struct {
+			Src       []string
+			Cdn       []string
+			CustomSrc []string
+			CustomCdn []string
+		}
+	}
+}
+
+func (d *ufileProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	var list = schema.NewResources()
+	gologger.Debug().Msg("正在获取 UCloud UFile 资源信息")
+	for offset := 0; ; offset += 100 {
+		var response describeBucketResponse
+		params := url.Values{"Offset": {strconv.Itoa(offset)}, "Limit": {"100"}}
+		if err := d.apiClient.call("DescribeBucket", params, &response); err != nil {
+			gologger.Debug().Msgf("无法获取 UFile 存储桶列表: %s", err)
+			break
+		}
+		for _, bucket := range response.DataSet {
+			var domains []string
+			domains = append(domains, bucket.Domain.Src...)
+			domains = append(domains, bucket.Domain.Cdn...)
+			domains = append(domains, bucket.Domain.CustomSrc...)
+			domains = append(domains, bucket.Domain.CustomCdn...)
+			for _, domain := range domains {
+				list.Append(&schema.Resource{
+					ID:         d.id,
+					Public:     true,
+					DNSName:    domain,
+					Provider:   d.provider,
+					Service:    "UFile",
+					Region:     bucket.Region,
+					ResourceID: bucket.BucketName,
+				})
+			}
+		}
+		if len(response.DataSet) < 100 {
+			break
+		}
+	}
+	return list, nil
+}
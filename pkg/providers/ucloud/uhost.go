@@ -0,0 +1,85 @@
+package ucloud
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type uhostProvider struct {
+	id        string
+	provider  string
+	apiClient *apiClient
+	regions   []string
+	list      *schema.Resources
+}
+
+type describeUHostInstanceResponse struct {
+	TotalCount int
+	UHostSet   []struct {
+		UHostId string
+		Name    string
+		IPSet   []struct {
+			Type string
+			IP   string
+		}
+	}
+}
+
+func (d *uhostProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeUHostInstance)
+	return d.list, nil
+}
+
+func (d *uhostProvider) describeUHostInstance(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的 UCloud UHost 资源信息", region)
+		for offset := 0; ; offset += 100 {
+			var response describeUHostInstanceResponse
+			params := url.Values{"Region": {region}, "Offset": {strconv.Itoa(offset)}, "Limit": {"100"}}
+			err = d.apiClient.call("DescribeUHostInstance", params, &response)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 UHost 资源: %s", region, err)
+				break
+			}
+			if len(response.UHostSet) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 UHost 资源", region, len(response.UHostSet))
+			}
+			for _, host := range response.UHostSet {
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+				)
+				// Type 为 Private 时是内网 IP，其他类型（BGP、International 等）为外网 IP
+				for _, ip := range host.IPSet {
+					if ip.Type == "Private" {
+						privateIPv4s = append(privateIPv4s, ip.IP)
+					} else {
+						publicIPv4s = append(publicIPv4s, ip.IP)
+					}
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "UHost",
+					Region:       region,
+					ResourceID:   host.UHostId,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					Public:       len(publicIPv4s) > 0,
+					Metadata:     map[string]string{"name": host.Name},
+				})
+			}
+			if offset+100 >= response.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package volcengine
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type clbProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	regions       []string
+	list          *schema.Resources
+}
+
+type describeLoadBalancersResult struct {
+	TotalCount    int
+	LoadBalancers []struct {
+		LoadBalancerId   string
+		LoadBalancerName string
+		Type             string
+		EniAddress       string
+		EipAddress       string
+		EniIpv6Address   string
+	}
+}
+
+func (d *clbProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeLoadBalancers)
+	return d.list, nil
+}
+
+func (d *clbProvider) describeLoadBalancers(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的火山引擎 CLB 资源信息", region)
+		for pageNumber := 1; ; pageNumber++ {
+			var result describeLoadBalancersResult
+			params := url.Values{"PageNumber": {strconv.Itoa(pageNumber)}, "PageSize": {"100"}}
+			err = d.openAPIClient.call(region, "clb", "2020-04-01", "DescribeLoadBalancers", params, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 CLB 资源: %s", region, err)
+				break
+			}
+			if len(result.LoadBalancers) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 CLB 资源", region, len(result.LoadBalancers))
+			}
+			for _, loadBalancer := range result.LoadBalancers {
+				d.list.Append(&schema.Resource{
+					ID:          d.id,
+					Provider:    d.provider,
+					Service:     "CLB",
+					Region:      region,
+					ResourceID:  loadBalancer.LoadBalancerId,
+					EIPs:        []string{loadBalancer.EipAddress},
+					PrivateIpv4: loadBalancer.EniAddress,
+					IPv6s:       []string{loadBalancer.EniIpv6Address},
+					Public:      loadBalancer.Type == "public",
+					Metadata:    map[string]string{"name": loadBalancer.LoadBalancerName, "type": loadBalancer.Type},
+				})
+			}
+			if pageNumber*100 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,160 @@
+package volcengine
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	openAPIHost   = "open.volcengineapi.com"
+	signAlgorithm = "HMAC-SHA256"
+)
+
+// openAPIClient 使用 HMAC-SHA256 签名调用火山引擎 OpenAPI
+type openAPIClient struct {
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	httpClient   *http.Client
+}
+
+// openAPIResponse 是火山引擎 OpenAPI 的通用返回结构
+type openAPIResponse struct {
+	ResponseMetadata struct {
+		RequestId string
+		Error     *struct {
+			Code    string
+			Message string
+		}
+	}
+	Result json.RawMessage
+}
+
+func newOpenAPIClient(accessKey, secretKey, sessionToken string) *openAPIClient {
+	return &openAPIClient{
+		accessKey:    accessKey,
+		secretKey:    secretKey,
+		sessionToken: sessionToken,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// call 调用 service 服务在 region 区域下的 action 接口，并将 Result 解析到 result 中
+func (c *openAPIClient) call(region, service, version, action string, params url.Values, result interface{}) error {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("Action", action)
+	query.Set("Version", version)
+
+	request, err := http.NewRequest(http.MethodGet, "https://"+openAPIHost+"/?"+canonicalQueryString(query), nil)
+	if err != nil {
+		return err
+	}
+	c.sign(request, region, service, nil)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	var apiResponse openAPIResponse
+	if err = json.Unmarshal(body, &apiResponse); err != nil {
+		return fmt.Errorf("%s %s: %s", response.Status, action, bytes.TrimSpace(body))
+	}
+	if apiResponse.ResponseMetadata.Error != nil {
+		return fmt.Errorf("%s: %s %s", action, apiResponse.ResponseMetadata.Error.Code, apiResponse.ResponseMetadata.Error.Message)
+	}
+	return json.Unmarshal(apiResponse.Result, result)
+}
+
+// sign 为请求添加 X-Date、X-Content-Sha256 和 Authorization 头
+func (c *openAPIClient) sign(request *http.Request, region, service string, body []byte) {
+	now := time.Now().UTC()
+	xDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	payloadHash := sha256.Sum256(body)
+	contentHash := hex.EncodeToString(payloadHash[:])
+
+	request.Header.Set("X-Date", xDate)
+	request.Header.Set("X-Content-Sha256", contentHash)
+	if c.sessionToken != "" {
+		request.Header.Set("X-Security-Token", c.sessionToken)
+	}
+
+	signedHeaders := []string{"host"}
+	for key := range request.Header {
+		signedHeaders = append(signedHeaders, strings.ToLower(key))
+	}
+	sort.Strings(signedHeaders)
+	var canonicalHeaders strings.Builder
+	for _, key := range signedHeaders {
+		value := request.Header.Get(key)
+		if key == "host" {
+			value = request.URL.Host
+		}
+		canonicalHeaders.WriteString(key + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		"/",
+		request.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		contentHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	credentialScope := shortDate + "/" + region + "/" + service + "/request"
+	stringToSign := signAlgorithm + "\n" + xDate + "\n" + credentialScope + "\n" + hex.EncodeToString(requestHash[:])
+
+	kDate := hmacSHA256([]byte(c.secretKey), shortDate)
+	kRegion := hmacSHA256(kDate, region)
+	kService := hmacSHA256(kRegion, service)
+	kSigning := hmacSHA256(kService, "request")
+	signature := hex.EncodeToString(hmacSHA256(kSigning, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signAlgorithm, c.accessKey, credentialScope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQueryString 按照参数名排序并按照 RFC 3986 编码
+func canonicalQueryString(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, escape(key)+"="+escape(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
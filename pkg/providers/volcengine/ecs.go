@@ -0,0 +1,85 @@
+package volcengine
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"sync"
+)
+
+type ecsProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	regions       []string
+	list          *schema.Resources
+}
+
+type describeInstancesResult struct {
+	NextToken string
+	Instances []struct {
+		InstanceId        string
+		InstanceName      string
+		NetworkInterfaces []struct {
+			PrimaryIpAddress string
+			Ipv6Addresses    []string
+		}
+		EipAddress struct {
+			IpAddress string
+		}
+	}
+}
+
+func (d *ecsProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeInstances)
+	return d.list, nil
+}
+
+func (d *ecsProvider) describeInstances(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的火山引擎 ECS 资源信息", region)
+		params := url.Values{"MaxResults": {"100"}}
+		for {
+			var result describeInstancesResult
+			err = d.openAPIClient.call(region, "ecs", "2020-04-01", "DescribeInstances", params, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 ECS 资源: %s", region, err)
+				break
+			}
+			if len(result.Instances) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 ECS 资源", region, len(result.Instances))
+			}
+			for _, instance := range result.Instances {
+				var (
+					privateIPv4s []string
+					ipv6s        []string
+				)
+				for _, networkInterface := range instance.NetworkInterfaces {
+					privateIPv4s = append(privateIPv4s, networkInterface.PrimaryIpAddress)
+					ipv6s = append(ipv6s, networkInterface.Ipv6Addresses...)
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "ECS",
+					Region:       region,
+					ResourceID:   instance.InstanceId,
+					EIPs:         []string{instance.EipAddress.IpAddress},
+					PrivateIpv4s: privateIPv4s,
+					IPv6s:        ipv6s,
+					Public:       instance.EipAddress.IpAddress != "" || len(ipv6s) > 0,
+					Metadata:     map[string]string{"name": instance.InstanceName},
+				})
+			}
+			if result.NextToken == "" {
+				break
+			}
+			params.Set("NextToken", result.NextToken)
+		}
+	}
+	return err
+}
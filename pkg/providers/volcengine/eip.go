@@ -0,0 +1,76 @@
+package volcengine
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type eipProvider struct {
+	id            string
+	provider      string
+	openAPIClient *openAPIClient
+	regions       []string
+	list          *schema.Resources
+}
+
+type describeEipAddressesResult struct {
+	TotalCount   int
+	EipAddresses []struct {
+		AllocationId string
+		EipAddress   string
+		Status       string
+		InstanceId   string
+		InstanceType string
+	}
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.regions, d.describeEipAddresses)
+	return d.list, nil
+}
+
+func (d *eipProvider) describeEipAddresses(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for region := range ch {
+		gologger.Debug().Msgf("正在获取 %s 区域下的火山引擎 EIP 资源信息", region)
+		for pageNumber := 1; ; pageNumber++ {
+			var result describeEipAddressesResult
+			params := url.Values{"PageNumber": {strconv.Itoa(pageNumber)}, "PageSize": {"100"}}
+			err = d.openAPIClient.call(region, "vpc", "2020-04-01", "DescribeEipAddresses", params, &result)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 区域下的 EIP 资源: %s", region, err)
+				break
+			}
+			if len(result.EipAddresses) > 0 {
+				gologger.Warning().Msgf("在 %s 区域下获取到 %d 条 EIP 资源", region, len(result.EipAddresses))
+			}
+			for _, eip := range result.EipAddresses {
+				metadata := map[string]string{"status": eip.Status}
+				if eip.InstanceId != "" {
+					metadata["instance_id"] = eip.InstanceId
+					metadata["instance_type"] = eip.InstanceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     region,
+					ResourceID: eip.AllocationId,
+					PublicIPv4: eip.EipAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if pageNumber*100 >= result.TotalCount {
+				break
+			}
+		}
+	}
+	return err
+}
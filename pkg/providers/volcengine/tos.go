@@ -0,0 +1,30 @@
+package volcengine
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/providers/s3"
+	"github.com/wgpsec/lc/pkg/schema"
+)
+
+type tosProvider struct {
+	id       string
+	provider string
+	config   providerConfig
+}
+
+// GetResource 通过 TOS 的 S3 兼容接口获取存储桶
+func (d *tosProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	gologger.Debug().Msg("正在获取火山引擎 TOS 资源信息")
+	lister := &s3.BucketLister{
+		ID:          d.id,
+		Provider:    d.provider,
+		Service:     "TOS",
+		Endpoint:    "https://tos-s3-cn-beijing.volces.com",
+		Region:      "cn-beijing",
+		Credentials: credentials.NewStaticCredentials(d.config.accessKeyID, d.config.accessKeySecret, d.config.sessionToken),
+		Host:        "{bucket}.tos-{region}.volces.com",
+	}
+	return lister.GetResource(ctx)
+}
@@ -0,0 +1,116 @@
+package volcengine
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"github.com/wgpsec/lc/utils"
+)
+
+type Provider struct {
+	id            string
+	provider      string
+	config        providerConfig
+	openAPIClient *openAPIClient
+	regions       []string
+}
+
+type providerConfig struct {
+	accessKeyID     string
+	accessKeySecret string
+	sessionToken    string
+}
+
+type describeRegionsResult struct {
+	Regions []struct {
+		RegionId string
+	}
+}
+
+func New(options schema.OptionBlock) (*Provider, error) {
+	accessKeyID, ok := options.GetMetadata(utils.AccessKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.AccessKey}
+	}
+	accessKeySecret, ok := options.GetMetadata(utils.SecretKey)
+	if !ok {
+		return nil, &utils.ErrNoSuchKey{Name: utils.SecretKey}
+	}
+	id, _ := options.GetMetadata(utils.Id)
+	sessionToken, okST := options.GetMetadata(utils.SessionToken)
+
+	if okST {
+		gologger.Debug().Msg("找到火山引擎临时访问凭证")
+	} else {
+		gologger.Debug().Msg("找到火山引擎永久访问凭证")
+	}
+
+	config := providerConfig{
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		sessionToken:    sessionToken,
+	}
+
+	// regions
+	openAPIClient := newOpenAPIClient(accessKeyID, accessKeySecret, sessionToken)
+	var result describeRegionsResult
+	err := openAPIClient.call("cn-beijing", "ecs", "2020-04-01", "DescribeRegions", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	var regions []string
+	for _, region := range result.Regions {
+		regions = append(regions, region.RegionId)
+	}
+
+	return &Provider{id: id, provider: utils.Volcengine, config: config, openAPIClient: openAPIClient, regions: regions}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.provider
+}
+func (p *Provider) ID() string {
+	return p.id
+}
+
+func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
+	var err error
+	ecsProvider := &ecsProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient, regions: p.regions}
+	ecsList, err := ecsProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条火山引擎 ECS 信息", len(ecsList.GetItems()))
+
+	clbProvider := &clbProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient, regions: p.regions}
+	clbList, err := clbProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条火山引擎 CLB 信息", len(clbList.GetItems()))
+
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, openAPIClient: p.openAPIClient, regions: p.regions}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条火山引擎 EIP 信息", len(eipList.GetItems()))
+
+	tosProvider := &tosProvider{id: p.id, provider: p.provider, config: p.config}
+	tosList, err := tosProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条火山引擎 TOS 信息", len(tosList.GetItems()))
+
+	finalList := schema.NewResources()
+	finalList.Merge(ecsList)
+	finalList.Merge(clbList)
+	finalList.Merge(eipList)
+	finalList.Merge(tosList)
+	finalList.AddSummary("ECS", len(ecsList.GetItems()))
+	finalList.AddSummary("CLB", len(clbList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
+	finalList.AddSummary("TOS", len(tosList.GetItems()))
+	return finalList, nil
+}
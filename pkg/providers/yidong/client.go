@@ -0,0 +1,124 @@
+package yidong
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ecloudClient 使用 HmacSHA1 签名调用移动云 OpenAPI
+type ecloudClient struct {
+	accessKey  string
+	secretKey  string
+	httpClient *http.Client
+}
+
+// ecloudResponse 是移动云 OpenAPI 的通用返回结构，state 为 OK 时表示成功
+type ecloudResponse struct {
+	State        string          `json:"state"`
+	ErrorCode    string          `json:"errorCode"`
+	ErrorMessage string          `json:"errorMessage"`
+	Body         json.RawMessage `json:"body"`
+}
+
+func newEcloudClient(accessKey, secretKey string) *ecloudClient {
+	return &ecloudClient{
+		accessKey:  accessKey,
+		secretKey:  secretKey,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// get 向 https://{host}{path} 发送已签名的 GET 请求，并将 body 解析到 result 中
+func (c *ecloudClient) get(host, path string, query url.Values, result interface{}) error {
+	requestURL := "https://" + host + path + "?" + c.sign(http.MethodGet, path, query)
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s", response.Status, path, bytes.TrimSpace(data))
+	}
+	var ecloudResult ecloudResponse
+	if err = json.Unmarshal(data, &ecloudResult); err != nil {
+		return err
+	}
+	if ecloudResult.State != "OK" {
+		return fmt.Errorf("%s: %s %s", path, ecloudResult.ErrorCode, ecloudResult.ErrorMessage)
+	}
+	return json.Unmarshal(ecloudResult.Body, result)
+}
+
+// sign 添加公共参数和 Signature，返回编码后的查询字符串
+func (c *ecloudClient) sign(method, path string, query url.Values) string {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+	params.Set("AccessKey", c.accessKey)
+	params.Set("SignatureMethod", "HmacSHA1")
+	params.Set("SignatureNonce", newNonce())
+	params.Set("SignatureVersion", "V2.0")
+	// Timestamp 使用北京时间
+	params.Set("Timestamp", time.Now().In(time.FixedZone("CST", 8*3600)).Format("2006-01-02T15:04:05Z"))
+
+	canonicalizedQuery := canonicalQueryString(params)
+	queryHash := sha256.Sum256([]byte(canonicalizedQuery))
+	stringToSign := method + "\n" + percentEncode(path) + "\n" + hex.EncodeToString(queryHash[:])
+
+	mac := hmac.New(sha1.New, []byte("BC_SIGNATURE&"+c.secretKey))
+	mac.Write([]byte(stringToSign))
+	return canonicalizedQuery + "&Signature=" + percentEncode(hex.EncodeToString(mac.Sum(nil)))
+}
+
+func canonicalQueryString(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, percentEncode(key)+"="+percentEncode(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// percentEncode 按照 RFC 3986 对字符串进行编码
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	s = strings.ReplaceAll(s, "%7E", "~")
+	return s
+}
+
+func newNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,78 @@
+package yidong
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type eipProvider struct {
+	id           string
+	provider     string
+	ecloudClient *ecloudClient
+	endpoints    []string
+	list         *schema.Resources
+}
+
+type listFloatingIPsBody struct {
+	Total   int `json:"total"`
+	Content []struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		IPAddress    string `json:"ipAddress"`
+		Region       string `json:"region"`
+		Bound        bool   `json:"bound"`
+		ResourceID   string `json:"resourceId"`
+		ResourceType string `json:"resourceType"`
+	} `json:"content"`
+}
+
+func (d *eipProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.endpoints, d.listFloatingIPs)
+	return d.list, nil
+}
+
+func (d *eipProvider) listFloatingIPs(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for endpoint := range ch {
+		gologger.Debug().Msgf("正在获取 %s 下的移动云 EIP 资源信息", endpoint)
+		for page := 1; ; page++ {
+			var body listFloatingIPsBody
+			query := url.Values{"page": {strconv.Itoa(page)}, "pageSize": {"50"}}
+			err = d.ecloudClient.get(endpoint, "/api/v2/floatingip", query, &body)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 下的 EIP 资源: %s", endpoint, err)
+				break
+			}
+			if len(body.Content) > 0 {
+				gologger.Warning().Msgf("在 %s 下获取到 %d 条 EIP 资源", endpoint, len(body.Content))
+			}
+			for _, eip := range body.Content {
+				metadata := map[string]string{}
+				if eip.Bound {
+					metadata["instance_id"] = eip.ResourceID
+					metadata["instance_type"] = eip.ResourceType
+				}
+				d.list.Append(&schema.Resource{
+					ID:         d.id,
+					Provider:   d.provider,
+					Service:    "EIP",
+					Region:     eip.Region,
+					ResourceID: eip.ID,
+					PublicIPv4: eip.IPAddress,
+					Public:     true,
+					Metadata:   metadata,
+				})
+			}
+			if page*50 >= body.Total {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -94,12 +94,7 @@ var resourcePools = []regions{
 }
 
 func (d *eosProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
-	var (
-		threads int
-		err     error
-		wg      sync.WaitGroup
-		buckets []string
-	)
+	var buckets []string
 
 	config := aws.NewConfig()
 	config.WithRegion("beijing1")
@@ -120,23 +115,9 @@ func (d *eosProvider) GetResource(ctx context.Context) (*schema.Resources, error
 	}
 	gologger.Debug().Msgf("找到 %d 个移动云 EOS 资源", len(buckets))
 
-	threads = schema.GetThreads()
-
-	taskCh := make(chan string, threads)
-	for i := 0; i < threads; i++ {
-		wg.Add(1)
-		go func() {
-			err = d.listBuckets(taskCh, &wg, s3Client)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	for _, item := range buckets {
-		taskCh <- item
-	}
-	close(taskCh)
-	wg.Wait()
+	schema.RunWorkers(buckets, func(ch <-chan string, wg *sync.WaitGroup) error {
+		return d.listBuckets(ch, wg, s3Client)
+	})
 	return list, nil
 
 }
@@ -161,10 +142,13 @@ func (d *eosProvider) listBuckets(ch <-chan string, wg *sync.WaitGroup, s3Client
 		}
 
 		list.Append(&schema.Resource{
-			ID:       d.id,
-			Public:   true,
-			DNSName:  endpointBuilder.String(),
-			Provider: d.provider,
+			ID:         d.id,
+			Public:     true,
+			DNSName:    endpointBuilder.String(),
+			Provider:   d.provider,
+			Service:    "EOS",
+			Region:     aws.StringValue(bucketLocation.LocationConstraint),
+			ResourceID: bucket,
 		})
 	}
 	return err
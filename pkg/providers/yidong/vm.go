@@ -0,0 +1,85 @@
+package yidong
+
+import (
+	"context"
+	"github.com/projectdiscovery/gologger"
+	"github.com/wgpsec/lc/pkg/schema"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+type vmProvider struct {
+	id           string
+	provider     string
+	ecloudClient *ecloudClient
+	endpoints    []string
+	list         *schema.Resources
+}
+
+type listServersBody struct {
+	Total   int `json:"total"`
+	Content []struct {
+		ID         string `json:"id"`
+		Name       string `json:"name"`
+		Region     string `json:"region"`
+		PortDetail []struct {
+			PrivateIP   string `json:"privateIp"`
+			FipAddress  string `json:"fipAddress"`
+			IPv6Address string `json:"ipv6Address"`
+		} `json:"portDetail"`
+	} `json:"content"`
+}
+
+func (d *vmProvider) GetResource(ctx context.Context) (*schema.Resources, error) {
+	d.list = schema.NewResources()
+	schema.RunWorkers(d.endpoints, d.listServers)
+	return d.list, nil
+}
+
+func (d *vmProvider) listServers(ch <-chan string, wg *sync.WaitGroup) error {
+	defer wg.Done()
+	var err error
+	for endpoint := range ch {
+		gologger.Debug().Msgf("正在获取 %s 下的移动云云主机资源信息", endpoint)
+		for page := 1; ; page++ {
+			var body listServersBody
+			query := url.Values{"page": {strconv.Itoa(page)}, "pageSize": {"50"}}
+			err = d.ecloudClient.get(endpoint, "/api/v2/server/web/with/network", query, &body)
+			if err != nil {
+				gologger.Debug().Msgf("无法获取 %s 下的云主机资源: %s", endpoint, err)
+				break
+			}
+			if len(body.Content) > 0 {
+				gologger.Warning().Msgf("在 %s 下获取到 %d 条云主机资源", endpoint, len(body.Content))
+			}
+			for _, server := range body.Content {
+				var (
+					publicIPv4s  []string
+					privateIPv4s []string
+					ipv6s        []string
+				)
+				for _, port := range server.PortDetail {
+					publicIPv4s = append(publicIPv4s, port.FipAddress)
+					privateIPv4s = append(privateIPv4s, port.PrivateIP)
+					ipv6s = append(ipv6s, port.IPv6Address)
+				}
+				d.list.Append(&schema.Resource{
+					ID:           d.id,
+					Provider:     d.provider,
+					Service:      "ECS",
+					Region:       server.Region,
+					ResourceID:   server.ID,
+					PublicIPv4s:  publicIPv4s,
+					PrivateIpv4s: privateIPv4s,
+					IPv6s:        ipv6s,
+					Metadata:     map[string]string{"name": server.Name},
+				})
+			}
+			if page*50 >= body.Total {
+				break
+			}
+		}
+	}
+	return err
+}
@@ -5,12 +5,15 @@ import (
 	"github.com/projectdiscovery/gologger"
 	"github.com/wgpsec/lc/pkg/schema"
 	"github.com/wgpsec/lc/utils"
+	"strings"
 )
 
 type Provider struct {
-	id       string
-	provider string
-	config   providerConfig
+	id           string
+	provider     string
+	config       providerConfig
+	ecloudClient *ecloudClient
+	endpoints    []string
 }
 
 type providerConfig struct {
@@ -37,12 +40,26 @@ func New(options schema.OptionBlock) (*Provider, error) {
 		gologger.Debug().Msg("找到移动云永久访问凭证")
 	}
 
+	// 移动云 OpenAPI 地址，多个资源池的地址之间使用逗号分隔
+	endpoint, ok := options.GetMetadata(utils.Endpoint)
+	if !ok {
+		endpoint = "ecloud.10086.cn"
+	}
+	var endpoints []string
+	for _, item := range strings.Split(endpoint, ",") {
+		item = strings.TrimPrefix(strings.TrimSpace(item), "https://")
+		if item != "" {
+			endpoints = append(endpoints, item)
+		}
+	}
+
 	config := providerConfig{
 		accessKeyID:     accessKeyID,
 		accessKeySecret: accessKeySecret,
 		sessionToken:    sessionToken,
 	}
-	return &Provider{id: id, provider: utils.YiDong, config: config}, nil
+	ecloudClient := newEcloudClient(accessKeyID, accessKeySecret)
+	return &Provider{id: id, provider: utils.YiDong, config: config, ecloudClient: ecloudClient, endpoints: endpoints}, nil
 }
 
 func (p *Provider) Name() string {
@@ -60,7 +77,24 @@ func (p *Provider) Resources(ctx context.Context) (*schema.Resources, error) {
 		return nil, err
 	}
 	gologger.Info().Msgf("获取到 %d 条移动云 EOS 信息", len(buckets.GetItems()))
+	vmProvider := &vmProvider{id: p.id, provider: p.provider, ecloudClient: p.ecloudClient, endpoints: p.endpoints}
+	vmList, err := vmProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条移动云云主机信息", len(vmList.GetItems()))
+	eipProvider := &eipProvider{id: p.id, provider: p.provider, ecloudClient: p.ecloudClient, endpoints: p.endpoints}
+	eipList, err := eipProvider.GetResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gologger.Info().Msgf("获取到 %d 条移动云 EIP 信息", len(eipList.GetItems()))
 	finalList := schema.NewResources()
 	finalList.Merge(buckets)
+	finalList.Merge(vmList)
+	finalList.Merge(eipList)
+	finalList.AddSummary("EOS", len(buckets.GetItems()))
+	finalList.AddSummary("ECS", len(vmList.GetItems()))
+	finalList.AddSummary("EIP", len(eipList.GetItems()))
 	return finalList, nil
 }
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"net"
+	"strings"
+)
+
+// DNSRecords 按照完整的域名聚合 A、AAAA 和 CNAME 解析记录，并转换为资产
+type DNSRecords struct {
+	fqdns   []string
+	records map[string]*dnsRecord
+}
+
+// dnsRecord 是同一个域名下所有解析记录的集合
+type dnsRecord struct {
+	resourceID string
+	types      []string
+	values     []string
+	proxied    []string
+	ipv4       []string
+	ipv6       []string
+}
+
+func NewDNSRecords() *DNSRecords {
+	return &DNSRecords{records: make(map[string]*dnsRecord)}
+}
+
+// Add 添加一条解析记录，A、AAAA 和 CNAME 以外的记录会被忽略。
+// 记录值是 IP 时会作为资产的地址，resourceID 为空时使用 AppendTo 中 base 的 ResourceID
+func (d *DNSRecords) Add(fqdn, recordType, value, resourceID string) {
+	record := d.record(fqdn, recordType, value, resourceID)
+	if record == nil {
+		return
+	}
+	value = strings.TrimSuffix(value, ".")
+	if net.ParseIP(value) == nil {
+		return
+	}
+	switch recordType {
+	case "A":
+		record.ipv4 = append(record.ipv4, value)
+	case "AAAA":
+		record.ipv6 = append(record.ipv6, value)
+	}
+}
+
+// AddProxied 添加一条经过 CDN 等代理的解析记录，记录值只写入 Metadata，不会作为资产的地址
+func (d *DNSRecords) AddProxied(fqdn, recordType, value, resourceID string) {
+	record := d.record(fqdn, recordType, value, resourceID)
+	if record == nil {
+		return
+	}
+	record.proxied = append(record.proxied, strings.TrimSuffix(value, "."))
+}
+
+func (d *DNSRecords) record(fqdn, recordType, value, resourceID string) *dnsRecord {
+	if recordType != "A" && recordType != "AAAA" && recordType != "CNAME" {
+		return nil
+	}
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	record, ok := d.records[fqdn]
+	if !ok {
+		record = &dnsRecord{resourceID: resourceID}
+		d.records[fqdn] = record
+		d.fqdns = append(d.fqdns, fqdn)
+	}
+	record.types = append(record.types, recordType)
+	record.values = append(record.values, strings.TrimSuffix(value, "."))
+	return record
+}
+
+// Len 返回聚合后的域名数量
+func (d *DNSRecords) Len() int {
+	return len(d.fqdns)
+}
+
+// AppendTo 把每个域名转换为一条资产添加到 list 中，base 提供 ID、Provider、Service 等公共字段，domain 为记录所属的主域名
+func (d *DNSRecords) AppendTo(list *Resources, base Resource, domain string) {
+	for _, fqdn := range d.fqdns {
+		record := d.records[fqdn]
+		resource := base
+		if record.resourceID != "" {
+			resource.ResourceID = record.resourceID
+		}
+		resource.DNSName = fqdn
+		resource.PublicIPv4s = record.ipv4
+		resource.IPv6s = record.ipv6
+		resource.Public = true
+		resource.Metadata = map[string]string{
+			"domain":       domain,
+			"record_type":  strings.Join(uniqueStrings(record.types), ", "),
+			"record_value": strings.Join(record.values, ", "),
+		}
+		if len(record.proxied) > 0 {
+			resource.Metadata["proxied_value"] = strings.Join(record.proxied, ", ")
+		}
+		list.Append(&resource)
+	}
+}
+
+// uniqueStrings 按照原有顺序去除重复的元素
+func uniqueStrings(items []string) []string {
+	var result []string
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	return result
+}
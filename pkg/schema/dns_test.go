@@ -0,0 +1,56 @@
+package schema
+
+import "testing"
+
+func TestDNSRecordsAppendTo(t *testing.T) {
+	records := NewDNSRecords()
+	records.Add("www.example.com.", "A", "47.100.1.1", "r-1")
+	records.Add("www.example.com", "A", "47.100.1.2", "r-2")
+	records.Add("www.example.com", "AAAA", "2408:4000::1", "r-3")
+	records.Add("cdn.example.com", "CNAME", "cdn.example.net.", "r-4")
+	// Route 53 的别名记录类型为 A，但记录值是域名
+	records.Add("lb.example.com", "A", "my-lb-1.us-east-1.elb.amazonaws.com", "")
+	records.AddProxied("proxy.example.com", "A", "47.100.1.3", "r-5")
+	records.Add("example.com", "MX", "mx.example.com", "r-6")
+	if records.Len() != 4 {
+		t.Fatalf("应该聚合为 4 个域名，实际为 %d 个", records.Len())
+	}
+
+	list := NewResources()
+	records.AppendTo(list, Resource{Provider: "aliyun", Service: "DNS", ResourceID: "zone-1"}, "example.com")
+	items := make(map[string]*Resource)
+	for _, item := range list.GetItems() {
+		for _, address := range item.addresses() {
+			if address != "" {
+				items[address] = item
+			}
+		}
+	}
+	if len(items) != 7 {
+		t.Fatalf("应该得到 4 个域名和 3 个 IP，实际为 %d 个: %v", len(items), items)
+	}
+
+	www := items["www.example.com"]
+	if www == nil || www.ResourceID != "r-1" || www.Metadata["record_type"] != "A, AAAA" ||
+		www.Metadata["record_value"] != "47.100.1.1, 47.100.1.2, 2408:4000::1" || www.Metadata["domain"] != "example.com" {
+		t.Errorf("www.example.com 的资产不正确: %+v", www)
+	}
+	if items["47.100.1.2"] == nil || items["2408:4000::1"] == nil || items["2408:4000::1"].PublicIPv6 == "" {
+		t.Errorf("A 和 AAAA 记录应该作为公网地址: %v", items)
+	}
+	if cdn := items["cdn.example.com"]; cdn == nil || cdn.Metadata["record_value"] != "cdn.example.net" {
+		t.Errorf("CNAME 记录的值不正确: %+v", cdn)
+	}
+	if items["cdn.example.net"] != nil || items["my-lb-1.us-east-1.elb.amazonaws.com"] != nil {
+		t.Errorf("CNAME 和别名记录的值不应该作为资产: %v", items)
+	}
+	if lb := items["lb.example.com"]; lb == nil || lb.ResourceID != "zone-1" {
+		t.Errorf("没有记录 ID 时应该使用 base 的 ResourceID: %+v", lb)
+	}
+	if items["47.100.1.3"] != nil {
+		t.Errorf("代理的记录值不应该作为资产的地址")
+	}
+	if proxy := items["proxy.example.com"]; proxy == nil || proxy.Metadata["proxied_value"] != "47.100.1.3" {
+		t.Errorf("代理的记录值应该写入 Metadata: %+v", proxy)
+	}
+}
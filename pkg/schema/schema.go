@@ -9,18 +9,38 @@ import (
 	"sync"
 )
 
-var uniqueMap *sync.Map
 var validator *validate.Validator
 var Threads int
 
 type Resources struct {
-	items []*Resource
+	items   []*Resource
+	summary []Summary
+	// unique 以域名或 IP 为 key 记录已经添加的资产，同一个地址只保留一条
+	unique map[string]*Resource
 	sync.RWMutex
 }
 
+// Summary 记录了某个云服务获取到的资产数量
+type Summary struct {
+	Service string `json:"service"`
+	Count   int    `json:"count"`
+}
+
 func (r *Resources) AppendItem(item *Resource) {
 	r.Lock()
 	defer r.Unlock()
+	r.appendItem(item)
+}
+
+func (r *Resources) appendItem(item *Resource) {
+	if r.unique == nil {
+		r.unique = make(map[string]*Resource)
+	}
+	for _, address := range item.addresses() {
+		if address != "" {
+			r.unique[address] = item
+		}
+	}
 	r.items = append(r.items, item)
 }
 func (r *Resources) GetItems() []*Resource {
@@ -29,6 +49,18 @@ func (r *Resources) GetItems() []*Resource {
 	return r.items
 }
 
+func (r *Resources) AddSummary(service string, count int) {
+	r.Lock()
+	defer r.Unlock()
+	r.summary = append(r.summary, Summary{Service: service, Count: count})
+}
+
+func (r *Resources) GetSummary() []Summary {
+	r.RLock()
+	defer r.RUnlock()
+	return r.summary
+}
+
 type Provider interface {
 	Name() string
 	ID() string
@@ -39,16 +71,29 @@ type Resource struct {
 	Public      bool   `json:"public"`
 	Provider    string `json:"provider"`
 	ID          string `json:"id,omitempty"`
+	Service     string `json:"service,omitempty"`     // Service 资产所属的云服务，例如 ECS、RDS、OSS
+	Region      string `json:"region,omitempty"`      // Region 资产所在的区域
+	ResourceID  string `json:"resource_id,omitempty"` // ResourceID 云服务商中的资源标识，例如实例 ID、存储桶名
 	PublicIPv4  string `json:"public_ipv4,omitempty"`
 	PrivateIpv4 string `json:"private_ipv4,omitempty"`
+	PublicIPv6  string `json:"public_ipv6,omitempty"`
+	PrivateIpv6 string `json:"private_ipv6,omitempty"`
 	DNSName     string `json:"dns_name,omitempty"`
+
+	// Metadata 记录资产的附加信息，例如 EIP 绑定的实例、NAT 的端口映射
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// 多网卡、多 IP 的实例可以把所有地址放到下面的列表中，Append 时会把每个地址拆分成单独的资产
+	PublicIPv4s  []string `json:"public_ipv4s,omitempty"`
+	PrivateIpv4s []string `json:"private_ipv4s,omitempty"`
+	EIPs         []string `json:"eips,omitempty"`
+	IPv6s        []string `json:"ipv6s,omitempty"` // IPv6s 会根据地址段自动区分公网和私网
 }
 
 type Options []OptionBlock
 type OptionBlock map[string]string
 
 func init() {
-	uniqueMap = &sync.Map{}
 	var err error
 	validator, err = validate.NewValidator()
 	if err != nil {
@@ -57,55 +102,101 @@ func init() {
 }
 
 // Resources
-func (r *Resources) appendResource(resource *Resource, uniqueMap *sync.Map) {
-	if _, ok := uniqueMap.Load(resource.DNSName); !ok && resource.DNSName != "" {
-		resourceType := validator.Identify(resource.DNSName)
-		r.appendResourceWithTypeAndMeta(resourceType, resource.DNSName, resource.ID, resource.Provider)
-		uniqueMap.Store(resource.DNSName, struct{}{})
+
+// appendResource 把资产中的每个地址拆分成单独的资产，地址已经存在时只合并 Metadata，
+// 因此同一个 EIP 在 ECS、EIP、NAT 等不同的列表中都会保留各自的信息
+func (r *Resources) appendResource(resource *Resource) {
+	for _, item := range resource.addresses() {
+		if item == "" {
+			continue
+		}
+		newResource := newResourceWithType(validator.Identify(item), item, resource)
+		if newResource == nil {
+			continue
+		}
+		r.Lock()
+		if existing, ok := r.unique[item]; ok {
+			existing.mergeMetadata(resource.Metadata)
+		} else {
+			r.appendItem(newResource)
+		}
+		r.Unlock()
 	}
-	if _, ok := uniqueMap.Load(resource.PublicIPv4); !ok && resource.PublicIPv4 != "" {
-		resourceType := validator.Identify(resource.PublicIPv4)
-		r.appendResourceWithTypeAndMeta(resourceType, resource.PublicIPv4, resource.ID, resource.Provider)
-		uniqueMap.Store(resource.PublicIPv4, struct{}{})
+}
+
+// addresses 返回资产中所有的域名和 IP 地址
+func (r *Resource) addresses() []string {
+	items := []string{r.DNSName, r.PublicIPv4, r.PrivateIpv4, r.PublicIPv6, r.PrivateIpv6}
+	items = append(items, r.PublicIPv4s...)
+	items = append(items, r.EIPs...)
+	items = append(items, r.PrivateIpv4s...)
+	items = append(items, r.IPv6s...)
+	return items
+}
+
+// mergeMetadata 把 metadata 中不存在的键合并到资产中，已有的键保持不变。
+// 同一来源拆分出的资产共用一个 Metadata，因此合并时会先复制一份
+func (r *Resource) mergeMetadata(metadata map[string]string) {
+	var merged map[string]string
+	for key, value := range metadata {
+		if _, ok := r.Metadata[key]; ok {
+			continue
+		}
+		if merged == nil {
+			merged = make(map[string]string, len(r.Metadata)+len(metadata))
+			for k, v := range r.Metadata {
+				merged[k] = v
+			}
+		}
+		merged[key] = value
 	}
-	if _, ok := uniqueMap.Load(resource.PrivateIpv4); !ok && resource.PrivateIpv4 != "" {
-		resourceType := validator.Identify(resource.PrivateIpv4)
-		r.appendResourceWithTypeAndMeta(resourceType, resource.PrivateIpv4, resource.ID, resource.Provider)
-		uniqueMap.Store(resource.PrivateIpv4, struct{}{})
+	if merged != nil {
+		r.Metadata = merged
 	}
 }
 
-func (r *Resources) appendResourceWithTypeAndMeta(resourceType validate.ResourceType, item, id, provider string) {
+// newResourceWithType 根据 item 的类型生成新的资产，并保留 meta 中的来源信息，无法识别的类型返回 nil
+func newResourceWithType(resourceType validate.ResourceType, item string, meta *Resource) *Resource {
 	resource := &Resource{
-		Provider: provider,
-		ID:       id,
+		Provider:   meta.Provider,
+		ID:         meta.ID,
+		Service:    meta.Service,
+		Region:     meta.Region,
+		ResourceID: meta.ResourceID,
+		Metadata:   meta.Metadata,
 	}
 	switch resourceType {
 	case validate.DNSName:
-		resource.Public = true
+		// 域名无法根据格式区分公网和内网，例如内网 ELB 和 RDS 的域名，因此使用云服务商返回的 Public
+		resource.Public = meta.Public
 		resource.DNSName = item
 	case validate.PublicIP:
 		resource.Public = true
 		resource.PublicIPv4 = item
 	case validate.PrivateIP:
 		resource.PrivateIpv4 = item
+	case validate.PublicIPv6:
+		resource.Public = true
+		resource.PublicIPv6 = item
+	case validate.PrivateIPv6:
+		resource.PrivateIpv6 = item
 	default:
-		return
+		return nil
 	}
-	r.AppendItem(resource)
+	return resource
 }
 
 func (r *Resources) Append(resource *Resource) {
-	r.appendResource(resource, uniqueMap)
+	r.appendResource(resource)
 }
 
+// Merge 把 resources 合并到当前列表中，不同云服务获取到的同一个地址会合并 Metadata
 func (r *Resources) Merge(resources *Resources) {
 	if resources == nil {
 		return
 	}
-	mergeUniqueMap := &sync.Map{}
 	for _, item := range resources.GetItems() {
-		r.appendResource(item, mergeUniqueMap)
+		r.appendResource(item)
 	}
 }
 
@@ -138,3 +229,25 @@ func SetThreads(threads int) {
 func GetThreads() int {
 	return Threads
 }
+
+// RunWorkers 使用 GetThreads 个线程并发处理 items，例如在所有区域下获取资源，work 需要在返回前调用 wg.Done
+func RunWorkers[T any](items []T, work func(ch <-chan T, wg *sync.WaitGroup) error) {
+	var wg sync.WaitGroup
+	threads := GetThreads()
+	if threads < 1 {
+		threads = 1
+	}
+
+	taskCh := make(chan T, threads)
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			_ = work(taskCh, &wg)
+		}()
+	}
+	for _, item := range items {
+		taskCh <- item
+	}
+	close(taskCh)
+	wg.Wait()
+}
@@ -0,0 +1,112 @@
+package schema
+
+import "testing"
+
+// 同一个 EIP 绑定在 ECS 实例上，同时又被 NAT 网关的 DNAT 规则使用
+func TestAppendKeepsEipSeenByEcsAndNat(t *testing.T) {
+	const eip = "47.100.1.1"
+	ecsList := NewResources()
+	eipList := NewResources()
+	natList := NewResources()
+
+	ecsList.Append(&Resource{
+		Provider:     "aliyun",
+		Service:      "ECS",
+		ResourceID:   "i-web",
+		EIPs:         []string{eip},
+		PrivateIpv4s: []string{"192.168.0.10"},
+		Metadata:     map[string]string{"name": "web"},
+	})
+	eipList.Append(&Resource{
+		Provider:   "aliyun",
+		Service:    "EIP",
+		ResourceID: "eip-1",
+		PublicIPv4: eip,
+		Metadata:   map[string]string{"status": "InUse", "instance_id": "i-web", "instance_type": "EcsInstance"},
+	})
+	natList.Append(&Resource{
+		Provider:   "aliyun",
+		Service:    "NAT",
+		ResourceID: "ngw-1",
+		PublicIPv4: eip,
+		Metadata:   map[string]string{"dnat": "tcp 80 -> 192.168.0.10:8080"},
+	})
+
+	eipItems := eipList.GetItems()
+	if len(eipItems) != 1 || eipItems[0].PublicIPv4 != eip || eipItems[0].Metadata["instance_id"] != "i-web" {
+		t.Fatalf("EIP 列表中缺少绑定到 ECS 的 EIP: %+v", eipItems)
+	}
+	natItems := natList.GetItems()
+	if len(natItems) != 1 || natItems[0].PublicIPv4 != eip || natItems[0].Metadata["dnat"] == "" {
+		t.Fatalf("NAT 列表中缺少 DNAT 使用的 EIP: %+v", natItems)
+	}
+
+	finalList := NewResources()
+	finalList.Merge(ecsList)
+	finalList.Merge(eipList)
+	finalList.Merge(natList)
+
+	var merged *Resource
+	for _, item := range finalList.GetItems() {
+		if item.PublicIPv4 == eip {
+			if merged != nil {
+				t.Fatalf("合并后 %s 出现了多次", eip)
+			}
+			merged = item
+		}
+	}
+	if merged == nil {
+		t.Fatalf("合并后缺少 %s", eip)
+	}
+	if merged.Service != "ECS" || merged.ResourceID != "i-web" {
+		t.Errorf("合并后应该保留最先添加的 ECS 资产，实际为 %s %s", merged.Service, merged.ResourceID)
+	}
+	for _, key := range []string{"name", "instance_id", "dnat"} {
+		if merged.Metadata[key] == "" {
+			t.Errorf("合并后的 Metadata 缺少 %s: %v", key, merged.Metadata)
+		}
+	}
+
+	// ECS 的内网地址与 EIP 共用同一个 Metadata，合并时不能修改它
+	for _, item := range ecsList.GetItems() {
+		if _, ok := item.Metadata["dnat"]; ok {
+			t.Errorf("合并修改了 ECS 列表中的 Metadata: %v", item.Metadata)
+		}
+	}
+}
+
+func TestAppendDeduplicatesWithinList(t *testing.T) {
+	list := NewResources()
+	list.Append(&Resource{Service: "EIP", PublicIPv4: "47.100.1.2", Metadata: map[string]string{"status": "Available"}})
+	list.Append(&Resource{Service: "EIP", PublicIPv4: "47.100.1.2", Metadata: map[string]string{"status": "InUse"}})
+	items := list.GetItems()
+	if len(items) != 1 {
+		t.Fatalf("同一个列表中的重复地址应该只保留一条，实际为 %d 条", len(items))
+	}
+	if items[0].Metadata["status"] != "Available" {
+		t.Errorf("已有的 Metadata 不应该被覆盖: %v", items[0].Metadata)
+	}
+}
+
+// 内网负载均衡和数据库的域名无法根据格式识别，应该使用云服务商返回的 Public
+func TestAppendKeepsPrivateDNSName(t *testing.T) {
+	list := NewResources()
+	list.Append(&Resource{Service: "ELB", DNSName: "internal-api-1.us-east-1.elb.amazonaws.com", PrivateIpv4: "10.0.0.5"})
+	list.Append(&Resource{Service: "ELB", DNSName: "web-1.us-east-1.elb.amazonaws.com", Public: true})
+
+	merged := NewResources()
+	merged.Merge(list)
+	public := make(map[string]bool)
+	for _, item := range merged.GetItems() {
+		public[item.DNSName+item.PrivateIpv4] = item.Public
+	}
+	if len(public) != 3 {
+		t.Fatalf("应该得到 2 个域名和 1 个内网 IP，实际为 %v", public)
+	}
+	if public["internal-api-1.us-east-1.elb.amazonaws.com"] || public["10.0.0.5"] {
+		t.Errorf("内网的域名和 IP 不应该作为公网资产: %v", public)
+	}
+	if !public["web-1.us-east-1.elb.amazonaws.com"] {
+		t.Errorf("公网的域名应该作为公网资产: %v", public)
+	}
+}
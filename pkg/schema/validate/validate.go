@@ -66,6 +66,8 @@ const (
 	DNSName ResourceType = iota + 1
 	PublicIP
 	PrivateIP
+	PublicIPv6
+	PrivateIPv6
 	None
 )
 
@@ -84,9 +86,9 @@ func (v *Validator) Identify(item string) ResourceType {
 	if strings.Contains(item, ":") {
 		// 检查 ipv6 私网地址列表
 		if v.containsIPv6(parsed) {
-			return PrivateIP
+			return PrivateIPv6
 		}
-		return PublicIP
+		return PublicIPv6
 	}
 	// 检查 ipv4 私网地址列表
 	if v.containsIPv4(parsed) {
@@ -1,20 +1,45 @@
 package utils
 
 const (
-	Provider     = "provider"
-	Id           = "id"
-	AccessKey    = "access_key"
-	SecretKey    = "secret_key"
-	SessionToken = "session_token"
+	Provider        = "provider"
+	Id              = "id"
+	AccessKey       = "access_key"
+	SecretKey       = "secret_key"
+	SessionToken    = "session_token"
+	Endpoint        = "endpoint"
+	Region          = "region"
+	AuthEndpoint    = "auth_endpoint"
+	TenantId        = "tenant_id"
+	SubscriptionId  = "subscription_id"
+	CredentialsFile = "credentials_file"
+	ProjectId       = "project_id"
+	APIToken        = "api_token"
+	Kubeconfig      = "kubeconfig"
+	Context         = "context"
+	AuthURL         = "auth_url"
+	Project         = "project"
+	Domain          = "domain"
+	PathStyle       = "path_style"
 )
 
 const (
-	Aliyun   = "aliyun"
-	Tencent  = "tencent"
-	Huawei   = "huawei"
-	TianYi   = "tianyi"
-	Baidu    = "baidu"
-	LianTong = "liantong"
-	QiNiu    = "qiniu"
-	YiDong   = "yidong"
+	Aliyun     = "aliyun"
+	Tencent    = "tencent"
+	Huawei     = "huawei"
+	TianYi     = "tianyi"
+	Baidu      = "baidu"
+	LianTong   = "liantong"
+	QiNiu      = "qiniu"
+	YiDong     = "yidong"
+	Aws        = "aws"
+	Volcengine = "volcengine"
+	JDCloud    = "jdcloud"
+	Kingsoft   = "kingsoft"
+	UCloud     = "ucloud"
+	Azure      = "azure"
+	GCP        = "gcp"
+	Cloudflare = "cloudflare"
+	Kubernetes = "kubernetes"
+	OpenStack  = "openstack"
+	S3         = "s3"
 )